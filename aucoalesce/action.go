@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import "github.com/elastic/go-libaudit/v2/auparse"
+
+// syscallActions maps syscall names to the coarse verb returned by Action.
+var syscallActions = map[string]string{
+	"execve":            "executed",
+	"execveat":          "executed",
+	"open":              "opened",
+	"openat":            "opened",
+	"openat2":           "opened",
+	"open_by_handle_at": "opened",
+	"creat":             "created",
+	"mkdir":             "created",
+	"mkdirat":           "created",
+	"mknod":             "created",
+	"mknodat":           "created",
+	"unlink":            "deleted",
+	"unlinkat":          "deleted",
+	"rmdir":             "deleted",
+	"rename":            "renamed",
+	"renameat":          "renamed",
+	"renameat2":         "renamed",
+	"link":              "linked",
+	"linkat":            "linked",
+	"symlink":           "linked",
+	"symlinkat":         "linked",
+	"chmod":             "modified",
+	"fchmod":            "modified",
+	"fchmodat":          "modified",
+	"chown":             "modified",
+	"fchown":            "modified",
+	"fchownat":          "modified",
+	"lchown":            "modified",
+	"truncate":          "modified",
+	"ftruncate":         "modified",
+	"connect":           "connected",
+	"accept":            "accepted",
+	"accept4":           "accepted",
+	"bind":              "bound",
+	"listen":            "listened",
+	"kill":              "killed",
+	"tkill":             "killed",
+	"tgkill":            "killed",
+	"mount":             "mounted",
+	"umount":            "unmounted",
+	"umount2":           "unmounted",
+	"init_module":       "loaded",
+	"finit_module":      "loaded",
+	"delete_module":     "unloaded",
+}
+
+// recordTypeActions maps the record types of non-syscall events to the coarse
+// verb returned by Action.
+var recordTypeActions = map[auparse.AuditMessageType]string{
+	auparse.AUDIT_USER_LOGIN:     "logged-in",
+	auparse.AUDIT_USER_LOGOUT:    "logged-out",
+	auparse.AUDIT_USER_AUTH:      "authenticated",
+	auparse.AUDIT_USER_CMD:       "executed",
+	auparse.AUDIT_USER_START:     "started-session",
+	auparse.AUDIT_USER_END:       "ended-session",
+	auparse.AUDIT_ADD_USER:       "created",
+	auparse.AUDIT_ADD_GROUP:      "created",
+	auparse.AUDIT_DEL_USER:       "deleted",
+	auparse.AUDIT_DEL_GROUP:      "deleted",
+	auparse.AUDIT_USER_CHAUTHTOK: "modified",
+	auparse.AUDIT_ANOM_ABEND:     "crashed",
+}
+
+// Action returns a coarse verb describing the primary action of the event
+// (e.g. executed, opened, connected, deleted, or logged-in) for grouping
+// events in dashboards. It is derived from the syscall, or from the record
+// type when the event is not a syscall, and does not depend on the result.
+// When neither is known the more detailed summary action is returned.
+func (e *Event) Action() string {
+	if verb, found := syscallActions[e.Data["syscall"]]; found {
+		return verb
+	}
+	if verb, found := recordTypeActions[e.Type]; found {
+		return verb
+	}
+	return e.Summary.Action
+}
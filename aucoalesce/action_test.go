@@ -0,0 +1,52 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventAction(t *testing.T) {
+	for expected, logs := range map[string]string{
+		"executed": `
+type=SYSCALL msg=audit(1611352900.100:1901): arch=c000003e syscall=59 success=yes exit=0 a0=55d1c2a3b4c0 a1=55d1c2a3b5e0 a2=55d1c2a3b600 a3=0 items=2 ppid=1500 pid=1901 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="id" exe="/usr/bin/id" key=(null)
+type=EXECVE msg=audit(1611352900.100:1901): argc=1 a0="id"
+type=PATH msg=audit(1611352900.100:1901): item=0 name="/usr/bin/id" inode=1234 dev=08:01 mode=0100755 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL
+`,
+		"deleted": `
+type=SYSCALL msg=audit(1611352900.200:1902): arch=c000003e syscall=87 success=yes exit=0 a0=7ffd2c1e9f12 a1=0 a2=0 a3=0 items=2 ppid=1500 pid=1902 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="rm" exe="/usr/bin/rm" key=(null)
+type=PATH msg=audit(1611352900.200:1902): item=0 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352900.200:1902): item=1 name="/tmp/file" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=DELETE
+`,
+		"logged-in": `
+type=USER_LOGIN msg=audit(1611352900.300:1903): pid=1903 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.2 terminal=/dev/pts/0 res=success'
+`,
+		// Falls back to the summary action.
+		"changed-system-name": `
+type=SYSCALL msg=audit(1611352900.400:1904): arch=c000003e syscall=170 success=yes exit=0 a0=55d1c2a3b4c0 a1=4 a2=0 a3=0 items=0 ppid=1500 pid=1904 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="hostname" exe="/usr/bin/hostname" key=(null)
+`,
+	} {
+		event, err := CoalesceMessages(parseLogLines(t, logs))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, event.Action())
+	}
+}
@@ -22,6 +22,7 @@ package aucoalesce
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -186,11 +187,23 @@ func CoalesceMessages(msgs []auparse.AuditMessage) (*Event, error) {
 	if event != nil {
 		applyNormalization(event)
 		addProcess(event)
+		addEnrichmentWarnings(msgs, event)
 	}
 
 	return event, err
 }
 
+// addEnrichmentWarnings adds the fields that could not be enriched in any of
+// the messages to the event's warnings.
+func addEnrichmentWarnings(msgs []auparse.AuditMessage, event *Event) {
+	for i := range msgs {
+		for _, err := range msgs[i].EnrichmentErrors() {
+			event.Warnings = append(event.Warnings, errors.Wrapf(err,
+				"failed to enrich %v message", msgs[i].RecordType))
+		}
+	}
+}
+
 // filterEOE returns a slice (backed by the given msgs slice) that does not
 // contain EOE (end-of-event) messages. EOE messages are sentinel messages used
 // to signal the completion of an event, but they carry no data.
@@ -207,13 +220,13 @@ func normalizeSimple(msg *auparse.AuditMessage) (*Event, error) {
 
 func normalizeCompound(msgs []auparse.AuditMessage) (*Event, error) {
 	var special, syscall *auparse.AuditMessage
-	for i, msg := range msgs {
-		if i == 0 && msg.RecordType != auparse.AUDIT_SYSCALL {
-			special = &msg
+	for i := range msgs {
+		if i == 0 && msgs[i].RecordType != auparse.AUDIT_SYSCALL {
+			special = &msgs[i]
 			continue
 		}
-		if msg.RecordType == auparse.AUDIT_SYSCALL {
-			syscall = &msg
+		if msgs[i].RecordType == auparse.AUDIT_SYSCALL {
+			syscall = &msgs[i]
 			break
 		}
 	}
@@ -239,9 +252,31 @@ func normalizeCompound(msgs []auparse.AuditMessage) (*Event, error) {
 		}
 	}
 
+	switch event.Data["syscall"] {
+	case "chdir", "chroot":
+		addTargetPath(event)
+	}
+
 	return event, nil
 }
 
+// addTargetPath sets target_path to the directory that is the target of a
+// chdir or chroot. The directory is taken from the PATH record and, if it is
+// relative, is resolved against the cwd of the process.
+func addTargetPath(event *Event) {
+	for _, p := range event.Paths {
+		name := p["name"]
+		if name == "" || p["item"] != "0" {
+			continue
+		}
+		if !filepath.IsAbs(name) && event.Data["cwd"] != "" {
+			name = filepath.Join(event.Data["cwd"], name)
+		}
+		event.Data["target_path"] = name
+		return
+	}
+}
+
 func newEvent(msg *auparse.AuditMessage, syscall *auparse.AuditMessage) *Event {
 	if msg == nil {
 		msg = syscall
@@ -466,7 +501,7 @@ func applyNormalization(event *Event) {
 	if event.Type == auparse.AUDIT_SYSCALL {
 		norm = syscallNorm
 	} else {
-		norms := recordTypeNorms[event.Type.String()]
+		norms := recordTypeNorms[event.Type.CanonicalName()]
 		switch len(norms) {
 		case 0:
 			// No normalization found.
@@ -616,8 +651,14 @@ func setSourceIP(key string, event *Event) error {
 	}
 	delete(event.Data, key)
 
+	// Some user space records log the port next to the address rather than
+	// a saddr. Use it so the source is the same as one derived from a saddr.
+	port := event.Data["port"]
+	delete(event.Data, "port")
+
 	event.Source = &Address{
-		IP: value,
+		IP:   value,
+		Port: port,
 	}
 	event.Net = &Network{
 		Direction: IncomingDir,
@@ -108,6 +108,119 @@ func testCoalesceEvent(t *testing.T, file string) {
 	}
 }
 
+func TestCoalesceMessagesSummary(t *testing.T) {
+	// tee opening /etc/hosts with O_WRONLY|O_CREAT|O_TRUNC.
+	msgs := parseLogLines(t, `
+type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=241 a3=1b6 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="tee" exe="/usr/bin/tee" key="hosts"
+type=CWD msg=audit(1611352422.102:1445): cwd="/root"
+type=PATH msg=audit(1611352422.102:1445): item=0 name="/etc/" inode=131073 dev=08:01 mode=040755 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352422.102:1445): item=1 name="/etc/hosts" inode=131090 dev=08:01 mode=0100644 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL
+type=PROCTITLE msg=audit(1611352422.102:1445): proctitle=746565002F6574632F686F737473
+`)
+
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, Summary{
+		Actor:  Actor{Primary: "1000", Secondary: "0"},
+		Action: "opened-file",
+		Object: Object{Type: "file", Primary: "/etc/hosts"},
+		How:    "/usr/bin/tee",
+	}, event.Summary)
+	if assert.NotNil(t, event.File) {
+		assert.Equal(t, "/etc/hosts", event.File.Path)
+		assert.Equal(t, "0644", event.File.Mode)
+	}
+	assert.Equal(t, "openat", event.Data["syscall"])
+	assert.Equal(t, "/usr/bin/tee", event.Process.Exe)
+	assert.Equal(t, []string{"hosts"}, event.Tags)
+}
+
+func TestCoalesceMessagesTargetPath(t *testing.T) {
+	// chroot into a directory given relative to the cwd.
+	msgs := parseLogLines(t, `
+type=SYSCALL msg=audit(1611352500.211:1502): arch=c000003e syscall=161 success=yes exit=0 a0=7ffd1b2c4f21 a1=0 a2=0 a3=7f3c0d1e2a40 items=1 ppid=1500 pid=1540 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="chroot" exe="/usr/sbin/chroot" key="chroot"
+type=CWD msg=audit(1611352500.211:1502): cwd="/srv"
+type=PATH msg=audit(1611352500.211:1502): item=0 name="jail" inode=262145 dev=08:01 mode=040755 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL
+type=PROCTITLE msg=audit(1611352500.211:1502): proctitle=6368726F6F74006A61696C
+`)
+
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "chroot", event.Data["syscall"])
+	assert.Equal(t, "/srv/jail", event.Data["target_path"])
+	assert.Equal(t, "/srv", event.Process.CWD)
+
+	// chdir with an absolute path.
+	msgs = parseLogLines(t, `
+type=SYSCALL msg=audit(1611352501.005:1503): arch=c000003e syscall=80 success=yes exit=0 a0=55e1c3a0b2c0 a1=0 a2=0 a3=0 items=1 ppid=1540 pid=1541 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="bash" exe="/bin/bash" key=(null)
+type=CWD msg=audit(1611352501.005:1503): cwd="/"
+type=PATH msg=audit(1611352501.005:1503): item=0 name="/tmp" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL
+`)
+
+	event, err = CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "/tmp", event.Data["target_path"])
+}
+
+func TestCoalesceMessagesFanotify(t *testing.T) {
+	// An open that was denied by a fanotify listener (e.g. fapolicyd).
+	msgs := parseLogLines(t, `
+type=FANOTIFY msg=audit(1611352600.310:1601): resp=2 fan_type=1 fan_info=3137 subj_trust=2 obj_trust=2
+type=SYSCALL msg=audit(1611352600.310:1601): arch=c000003e syscall=257 success=no exit=-1 a0=ffffff9c a1=7ffc4b2a3f10 a2=0 a3=0 items=1 ppid=1500 pid=1602 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="cat" exe="/usr/bin/cat" key=(null)
+type=CWD msg=audit(1611352600.310:1601): cwd="/home/user"
+type=PATH msg=audit(1611352600.310:1601): item=0 name="/tmp/evil.sh" inode=3145729 dev=fd:00 mode=0100755 ouid=1000 ogid=1000 rdev=00:00 nametype=NORMAL
+type=PROCTITLE msg=audit(1611352600.310:1601): proctitle=636174002F746D702F6576696C2E7368
+`)
+
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, auparse.AUDIT_FANOTIFY, event.Type)
+	assert.Equal(t, "deny", event.Data["resp"])
+	assert.Equal(t, "fail", event.Result)
+	assert.Equal(t, "decided-file-access", event.Summary.Action)
+	assert.Equal(t, "file", event.Summary.Object.Type)
+	assert.Equal(t, "/tmp/evil.sh", event.Summary.Object.Primary)
+	if assert.NotNil(t, event.File) {
+		assert.Equal(t, "/tmp/evil.sh", event.File.Path)
+	}
+}
+
+func TestCoalesceMessagesDirectAddress(t *testing.T) {
+	// A user space record that logs addr and port directly.
+	direct, err := CoalesceMessages(parseLogLines(t, `
+type=USER_LOGIN msg=audit(1611352800.100:1801): pid=1801 uid=0 auid=1000 ses=3 msg='op=login acct="alice" exe="/usr/sbin/sshd" hostname=? addr=1.2.3.4 port=443 terminal=ssh res=success'
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An accept whose peer address comes from a saddr.
+	accept, err := CoalesceMessages(parseLogLines(t, `
+type=SYSCALL msg=audit(1611352800.200:1802): arch=c000003e syscall=43 success=yes exit=5 a0=3 a1=7ffd3c5a1e40 a2=7ffd3c5a1e3c a3=0 items=0 ppid=1 pid=1802 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="sshd" exe="/usr/sbin/sshd" key=(null)
+type=SOCKADDR msg=audit(1611352800.200:1802): saddr=020001BB010203040000000000000000
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.NotNil(t, direct.Source) {
+		assert.Equal(t, Address{IP: "1.2.3.4", Port: "443"}, *direct.Source)
+		assert.Equal(t, accept.Source, direct.Source)
+	}
+	assert.NotContains(t, direct.Data, "port")
+}
+
 func readEventsFromYAML(t testing.TB, name string) []testEvent {
 	file, err := ioutil.ReadFile(name)
 	if err != nil {
@@ -176,6 +176,18 @@ func ResolveIDsFromCaches(event *Event, users, groups *EntityCache) {
 		}
 	}
 
+	// IPC object owner/group
+	if id := event.Data["ouid"]; id != "" {
+		if v := users.LookupID(id); v != "" {
+			event.Data["owner"] = v
+		}
+	}
+	if id := event.Data["ogid"]; id != "" {
+		if v := groups.LookupID(id); v != "" {
+			event.Data["group"] = v
+		}
+	}
+
 	// ECS User and groups
 	event.ECS.User.lookup(users)
 	event.ECS.Group.lookup(groups)
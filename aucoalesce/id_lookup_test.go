@@ -104,3 +104,22 @@ func TestHardcoded(t *testing.T) {
 	assert.Equal(t, grp.Name, groupLookup.LookupID(grp.Gid))
 	assert.Equal(t, grp.Gid, groupLookup.LookupName(grp.Name))
 }
+
+func TestResolveIPCOwner(t *testing.T) {
+	HardcodeUsers(user.User{Uid: "44", Username: "ipc_owner"})
+	HardcodeGroups(user.Group{Gid: "45", Name: "ipc_group"})
+
+	msgs := parseLogLines(t, `
+type=SYSCALL msg=audit(1611352700.120:1701): arch=c000003e syscall=71 success=yes exit=0 a0=8000 a1=1 a2=7ffd3c5a1e40 a3=0 items=0 ppid=1500 pid=1701 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="ipc" exe="/usr/bin/ipc" key=(null)
+type=IPC_SET_PERM msg=audit(1611352700.120:1701): qbytes=4000 ouid=44 ogid=45 mode=0660
+`)
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ResolveIDs(event)
+
+	assert.Equal(t, "rw-rw----", event.Data["perm"])
+	assert.Equal(t, "ipc_owner", event.Data["owner"])
+	assert.Equal(t, "ipc_group", event.Data["group"])
+}
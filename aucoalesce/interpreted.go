@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-libaudit/v2/auparse"
+)
+
+// interpretedTimeLayout is the timestamp layout used by ausearch -i.
+const interpretedTimeLayout = "01/02/2006 15:04:05.000"
+
+// FormatInterpreted renders the messages of an event (as passed to
+// CoalesceMessages) in a format that approximates the output of
+// `ausearch -i`. Like ausearch, the records are listed in reverse order, user
+// and group IDs are resolved to names and the rule keys are listed as key.
+// The record types are shown by their canonical names, even if an alias was
+// registered. The timestamps are converted to loc.
+//
+// The values of the fields are the values returned by Data, which differ from
+// the ones interpreted by ausearch in a few ways:
+//
+//   - success=yes and res=success are reported as result=success.
+//   - Syscall arguments are shown in hex (a0=0xffffff9c) and are not decoded
+//     into names like AT_FDCWD or O_RDONLY.
+//   - File modes are shown in octal (mode=0100644 instead of mode=file,644).
+func FormatInterpreted(msgs []auparse.AuditMessage, loc *time.Location) (string, error) {
+	var b strings.Builder
+	b.WriteString("----\n")
+	for i := len(msgs) - 1; i >= 0; i-- {
+		msg := &msgs[i]
+		if msg.RecordType == auparse.AUDIT_EOE {
+			continue
+		}
+
+		fields, err := msg.OrderedFields()
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString("type=")
+		b.WriteString(msg.RecordType.CanonicalName())
+		b.WriteString(" msg=audit(")
+		b.WriteString(msg.Timestamp.In(loc).Format(interpretedTimeLayout))
+		b.WriteByte(':')
+		b.WriteString(strconv.FormatUint(uint64(msg.Sequence), 10))
+		b.WriteString(") :")
+		for _, f := range fields {
+			b.WriteByte(' ')
+			b.WriteString(f.Key)
+			b.WriteByte('=')
+			b.WriteString(interpretValue(f.Key, f.Value))
+		}
+		if tags, _ := msg.Tags(); len(tags) > 0 {
+			b.WriteString(" key=")
+			b.WriteString(strings.Join(tags, ","))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
+
+// interpretValue returns the value of key as shown by ausearch -i.
+func interpretValue(key, value string) string {
+	switch {
+	case value == "unset":
+		return value
+	case strings.HasSuffix(key, "uid"):
+		if name := userLookup.LookupID(value); name != "" {
+			return name
+		}
+	case strings.HasSuffix(key, "gid"):
+		if name := groupLookup.LookupID(value); name != "" {
+			return name
+		}
+	case len(key) == 2 && key[0] == 'a' && key[1] >= '0' && key[1] <= '9':
+		// Syscall arguments are logged in hex without a prefix.
+		return "0x" + value
+	}
+	return value
+}
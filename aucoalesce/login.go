@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import "github.com/elastic/go-libaudit/v2/auparse"
+
+// LoginEvent describes a login or logout.
+type LoginEvent struct {
+	Logout   bool   `json:"logout"             yaml:"logout"`
+	Account  string `json:"account,omitempty"  yaml:"account,omitempty"`  // Account name, or the ID when no name was logged.
+	Result   string `json:"result,omitempty"   yaml:"result,omitempty"`   // success or fail.
+	Terminal string `json:"terminal,omitempty" yaml:"terminal,omitempty"` // e.g. /dev/pts/1, sshd, or tty1.
+	Address  string `json:"address,omitempty"  yaml:"address,omitempty"`  // Address of a remote login.
+	Session  string `json:"session,omitempty"  yaml:"session,omitempty"`
+}
+
+// Login returns a LoginEvent if the event is a LOGIN, USER_LOGIN, or
+// USER_LOGOUT. Otherwise it returns nil.
+func (e *Event) Login() *LoginEvent {
+	login := &LoginEvent{
+		Result:  e.Result,
+		Session: e.Session,
+	}
+
+	switch e.Type {
+	case auparse.AUDIT_LOGIN:
+		// The kernel logs the auid that is assigned to the new session.
+		login.Account = e.User.IDs["auid"]
+		if tty := e.Data["tty"]; tty != "(none)" {
+			login.Terminal = tty
+		}
+	case auparse.AUDIT_USER_LOGOUT:
+		login.Logout = true
+		fallthrough
+	case auparse.AUDIT_USER_LOGIN:
+		login.Account = e.Data["acct"]
+		if login.Account == "" {
+			login.Account = e.Data["id"]
+		}
+		login.Terminal = e.Data["terminal"]
+		if e.Source != nil {
+			login.Address = e.Source.IP
+		}
+	default:
+		return nil
+	}
+	return login
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventLogin(t *testing.T) {
+	tests := []struct {
+		name  string
+		log   string
+		login *LoginEvent
+	}{
+		{
+			"successful login",
+			`type=USER_LOGIN msg=audit(1492810797.778:12651): pid=11396 uid=0 auid=1001 ses=36 msg='op=login id=1001 exe="/usr/sbin/sshd" hostname=72.83.230.100 addr=72.83.230.100 terminal=/dev/pts/1 res=success'`,
+			&LoginEvent{Account: "1001", Result: "success", Terminal: "/dev/pts/1", Address: "72.83.230.100", Session: "36"},
+		},
+		{
+			"failed login",
+			`type=USER_LOGIN msg=audit(1492896301.818:19955): pid=12635 uid=0 auid=4294967295 ses=4294967295 msg='op=login acct=28696E76616C6964207573657229 exe="/usr/sbin/sshd" hostname=? addr=179.38.151.221 terminal=sshd res=failed'`,
+			&LoginEvent{Account: "(invalid user)", Result: "fail", Terminal: "sshd", Address: "179.38.151.221", Session: "unset"},
+		},
+		{
+			"logout",
+			`type=USER_LOGOUT msg=audit(1492811266.540:12686): pid=11396 uid=0 auid=1001 ses=36 msg='op=login id=1001 exe="/usr/sbin/sshd" hostname=? addr=? terminal=/dev/pts/1 res=success'`,
+			&LoginEvent{Logout: true, Account: "1001", Result: "success", Terminal: "/dev/pts/1", Session: "36"},
+		},
+		{
+			"kernel login",
+			`type=LOGIN msg=audit(1492810797.716:12646): pid=11396 uid=0 old-auid=4294967295 auid=1001 tty=(none) old-ses=4294967295 ses=36 res=1`,
+			&LoginEvent{Account: "1001", Result: "success", Session: "36"},
+		},
+		{
+			"not a login",
+			`type=CWD msg=audit(1611352422.102:1445): cwd="/root"`,
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		event, err := CoalesceMessages(parseLogLines(t, tc.log))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.login, event.Login(), tc.name)
+	}
+}
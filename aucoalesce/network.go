@@ -0,0 +1,131 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import "strconv"
+
+// NetworkConnection summarizes the connection described by a socket syscall
+// event (e.g. connect or accept) and its SOCKADDR record.
+type NetworkConnection struct {
+	Direction Direction `json:"direction"         yaml:"direction"`
+	Family    string    `json:"family,omitempty"  yaml:"family,omitempty"`    // Address family (e.g. ipv4, ipv6, or unix).
+	Address   string    `json:"address,omitempty" yaml:"address,omitempty"`   // IP address of the remote end.
+	Port      string    `json:"port,omitempty"    yaml:"port,omitempty"`      // Port of the remote end.
+	Path      string    `json:"path,omitempty"    yaml:"path,omitempty"`      // Path of a unix socket.
+	Protocol  string    `json:"protocol,omitempty" yaml:"protocol,omitempty"` // Protocol (e.g. tcp, udp, or rfcomm).
+	Result    string    `json:"result,omitempty"  yaml:"result,omitempty"`    // success or fail.
+}
+
+// NetworkConnection returns a NetworkConnection if the event has a remote
+// address with a known direction (e.g. a connect with a SOCKADDR record).
+// Otherwise it returns nil. The Protocol is only set if the SOCKADDR record
+// names it (e.g. for bluetooth). Use SocketConnection to take it from the
+// socket syscall that created the socket.
+func (e *Event) NetworkConnection() *NetworkConnection {
+	if e.Net == nil {
+		return nil
+	}
+
+	remote := e.Dest
+	if e.Net.Direction == IncomingDir {
+		remote = e.Source
+	}
+	if remote == nil {
+		return nil
+	}
+
+	return &NetworkConnection{
+		Direction: e.Net.Direction,
+		Family:    e.Data["socket_family"],
+		Address:   remote.IP,
+		Port:      remote.Port,
+		Path:      remote.Path,
+		Protocol:  e.Data["socket_protocol"],
+		Result:    e.Result,
+	}
+}
+
+// SocketConnection returns the NetworkConnection of conn with the Protocol
+// taken from the type and protocol arguments of socket, the socket syscall
+// event that created the socket used by conn (e.g. tcp for a SOCK_STREAM inet
+// socket). The Protocol is left as is if socket is not a successful socket
+// call of the same process that returned the file descriptor in a0 of conn.
+func SocketConnection(socket, conn *Event) *NetworkConnection {
+	c := conn.NetworkConnection()
+	if c == nil || socket == nil || socket.Data["syscall"] != "socket" ||
+		socket.Result != "success" || socket.Process.PID != conn.Process.PID {
+		return c
+	}
+
+	fd, err := strconv.ParseUint(conn.Data["a0"], 16, 64)
+	if err != nil || socket.Data["exit"] != strconv.FormatUint(fd, 10) {
+		return c
+	}
+	if protocol := socketProtocol(socket.Data["a0"], socket.Data["a1"], socket.Data["a2"]); protocol != "" {
+		c.Protocol = protocol
+	}
+	return c
+}
+
+// Address families and socket types of the socket syscall as defined in
+// include/linux/socket.h and include/linux/net.h.
+const (
+	afInet       = 2
+	afInet6      = 10
+	sockStream   = 1
+	sockDgram    = 2
+	sockTypeMask = 0xf // The type is or'd with SOCK_NONBLOCK and SOCK_CLOEXEC.
+)
+
+// ipProtocols are the names of common IP protocol numbers.
+var ipProtocols = map[uint64]string{
+	1:   "icmp",
+	6:   "tcp",
+	17:  "udp",
+	58:  "ipv6-icmp",
+	132: "sctp",
+}
+
+// socketProtocol returns the protocol of an inet socket created with the
+// given hex encoded socket arguments. A protocol of 0 selects the default
+// protocol of the socket type.
+func socketProtocol(family, typ, protocol string) string {
+	f, err := strconv.ParseUint(family, 16, 64)
+	if err != nil || (f != afInet && f != afInet6) {
+		return ""
+	}
+	t, err := strconv.ParseUint(typ, 16, 64)
+	if err != nil {
+		return ""
+	}
+	p, err := strconv.ParseUint(protocol, 16, 64)
+	if err != nil {
+		return ""
+	}
+
+	if p != 0 {
+		return ipProtocols[p]
+	}
+	switch t & sockTypeMask {
+	case sockStream:
+		return "tcp"
+	case sockDgram:
+		return "udp"
+	}
+	return ""
+}
@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventNetworkConnection(t *testing.T) {
+	// A non-blocking connect to 169.254.169.254:80 (EINPROGRESS).
+	msgs := parseLogLines(t, `
+type=SYSCALL msg=audit(1492753107.096:9004): arch=c000003e syscall=42 success=no exit=-115 a0=5 a1=7ffc12ac3ab0 a2=10 a3=4 items=0 ppid=1 pid=1648 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="google_ip_forwa" exe="/usr/bin/python3.5" key="key=net"
+type=SOCKADDR msg=audit(1492753107.096:9004): saddr=02000050A9FEA9FE0000000000000000
+type=PROCTITLE msg=audit(1492753107.096:9004): proctitle="(g_daemon)"
+`)
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &NetworkConnection{
+		Direction: OutgoingDir,
+		Family:    "ipv4",
+		Address:   "169.254.169.254",
+		Port:      "80",
+		Result:    "fail",
+	}, event.NetworkConnection())
+
+	// The protocol is taken from the socket call that returned fd 5.
+	socketMsgs := parseLogLines(t, `
+type=SYSCALL msg=audit(1492753107.095:9003): arch=c000003e syscall=41 success=yes exit=5 a0=2 a1=80801 a2=0 a3=0 items=0 ppid=1 pid=1648 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="google_ip_forwa" exe="/usr/bin/python3.5" key="key=net"
+type=PROCTITLE msg=audit(1492753107.095:9003): proctitle="(g_daemon)"
+`)
+	socket, err := CoalesceMessages(socketMsgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &NetworkConnection{
+		Direction: OutgoingDir,
+		Family:    "ipv4",
+		Address:   "169.254.169.254",
+		Port:      "80",
+		Protocol:  "tcp",
+		Result:    "fail",
+	}, SocketConnection(socket, event))
+
+	// A socket of another process says nothing about the connection.
+	socket.Process.PID = "1649"
+	assert.Empty(t, SocketConnection(socket, event).Protocol)
+
+	// An accept from 72.83.230.100.
+	msgs = parseLogLines(t, `
+type=SYSCALL msg=audit(1492752520.441:8832): arch=c000003e syscall=43 success=yes exit=5 a0=3 a1=7ffd0dc80040 a2=7ffd0dc7ffd0 a3=0 items=0 ppid=1 pid=1663 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="sshd" exe="/usr/sbin/sshd" key="key=net"
+type=SOCKADDR msg=audit(1492752520.441:8832): saddr=0200E31C4853E6640000000000000000
+`)
+	event, err = CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn := event.NetworkConnection()
+	if assert.NotNil(t, conn) {
+		assert.Equal(t, IncomingDir, conn.Direction)
+		assert.Equal(t, "72.83.230.100", conn.Address)
+		assert.Equal(t, "success", conn.Result)
+	}
+
+	// bind has no remote end.
+	msgs = parseLogLines(t, `
+type=SYSCALL msg=audit(1492752520.441:8833): arch=c000003e syscall=49 success=yes exit=0 a0=3 a1=7ffd0dc80040 a2=10 a3=0 items=0 ppid=1 pid=1663 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="sshd" exe="/usr/sbin/sshd" key="key=net"
+type=SOCKADDR msg=audit(1492752520.441:8833): saddr=02000016000000000000000000000000
+`)
+	event, err = CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, event.NetworkConnection())
+}
+
+func TestSocketProtocol(t *testing.T) {
+	for args, protocol := range map[[3]string]string{
+		{"2", "1", "0"}:     "tcp",
+		{"a", "80802", "0"}: "udp",
+		{"2", "2", "11"}:    "udp",
+		{"2", "3", "1"}:     "icmp",
+		{"a", "1", "84"}:    "sctp",
+		{"1", "1", "0"}:     "", // AF_UNIX
+		{"2", "3", "0"}:     "",
+	} {
+		assert.Equal(t, protocol, socketProtocol(args[0], args[1], args[2]), args)
+	}
+}
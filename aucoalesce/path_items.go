@@ -0,0 +1,118 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"sort"
+	"strconv"
+)
+
+// PathItem describes a file that was referenced by a PATH record of an event.
+type PathItem struct {
+	Item     int    `json:"item"               yaml:"item"`
+	Name     string `json:"name,omitempty"     yaml:"name,omitempty"`
+	NameType string `json:"nametype,omitempty" yaml:"nametype,omitempty"` // e.g. NORMAL, PARENT, CREATE, DELETE.
+	Mode     string `json:"mode,omitempty"     yaml:"mode,omitempty"`
+	Device   string `json:"device,omitempty"   yaml:"device,omitempty"`
+	Inode    string `json:"inode,omitempty"    yaml:"inode,omitempty"`
+}
+
+// PathItems returns the files referenced by the PATH records of the event
+// ordered by their item index. It is named PathItems because Paths holds the
+// raw key value pairs of the PATH records.
+func (e *Event) PathItems() []PathItem {
+	if len(e.Paths) == 0 {
+		return nil
+	}
+
+	items := make([]PathItem, 0, len(e.Paths))
+	for _, p := range e.Paths {
+		item, err := strconv.Atoi(p["item"])
+		if err != nil {
+			item = len(items)
+		}
+		items = append(items, PathItem{
+			Item:     item,
+			Name:     p["name"],
+			NameType: p["nametype"],
+			Mode:     p["mode"],
+			Device:   p["dev"],
+			Inode:    p["inode"],
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Item < items[j].Item })
+	return items
+}
+
+// FilePair is the source and destination of a rename or link.
+type FilePair struct {
+	From PathItem `json:"from" yaml:"from"`
+	To   PathItem `json:"to"   yaml:"to"`
+}
+
+// RenamePair returns the source and destination of a rename-like event (e.g.
+// rename, renameat2, or link) by pairing the PATH item that was created with
+// the DELETE item, or for links the NORMAL item, that refers to the same
+// inode and device. nil is returned if the event has no such items.
+func (e *Event) RenamePair() *FilePair {
+	items := e.PathItems()
+	for _, to := range items {
+		if to.NameType != "CREATE" {
+			continue
+		}
+
+		var from *PathItem
+		for i, item := range items {
+			if item.Inode != to.Inode || item.Device != to.Device {
+				continue
+			}
+			switch item.NameType {
+			case "DELETE":
+				return &FilePair{From: item, To: to}
+			case "NORMAL":
+				if from == nil {
+					from = &items[i]
+				}
+			}
+		}
+		if from != nil {
+			return &FilePair{From: *from, To: to}
+		}
+	}
+	return nil
+}
+
+// Hardlink returns the existing file and the new name of a hard link created
+// by a link or linkat syscall. The items are correlated by the CREATE item
+// sharing the inode and device of a NORMAL item, which can be used to detect
+// hard links that are made to files owned by other users. nil is returned if
+// the event is not a hard link creation.
+func (e *Event) Hardlink() *FilePair {
+	switch e.Data["syscall"] {
+	case "link", "linkat":
+	default:
+		return nil
+	}
+
+	pair := e.RenamePair()
+	if pair == nil || pair.From.NameType != "NORMAL" {
+		return nil
+	}
+	return pair
+}
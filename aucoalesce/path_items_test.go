@@ -0,0 +1,136 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventPathItems(t *testing.T) {
+	// mv /tmp/old /tmp/new with the PATH records out of order.
+	msgs := parseLogLines(t, `
+type=SYSCALL msg=audit(1611352600.301:1601): arch=c000003e syscall=316 success=yes exit=0 a0=ffffff9c a1=7ffd2c1e9f12 a2=ffffff9c a3=7ffd2c1e9f1b items=4 ppid=1500 pid=1601 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="mv" exe="/usr/bin/mv" key="tmp"
+type=CWD msg=audit(1611352600.301:1601): cwd="/home/vagrant"
+type=PATH msg=audit(1611352600.301:1601): item=0 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352600.301:1601): item=1 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352600.301:1601): item=3 name="/tmp/new" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=CREATE
+type=PATH msg=audit(1611352600.301:1601): item=2 name="/tmp/old" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=DELETE
+`)
+
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []PathItem{
+		{Item: 0, Name: "/tmp/", NameType: "PARENT", Mode: "041777", Device: "08:01", Inode: "2"},
+		{Item: 1, Name: "/tmp/", NameType: "PARENT", Mode: "041777", Device: "08:01", Inode: "2"},
+		{Item: 2, Name: "/tmp/old", NameType: "DELETE", Mode: "0100644", Device: "08:01", Inode: "1310"},
+		{Item: 3, Name: "/tmp/new", NameType: "CREATE", Mode: "0100644", Device: "08:01", Inode: "1310"},
+	}, event.PathItems())
+
+	assert.Nil(t, (&Event{}).PathItems())
+}
+
+func TestEventRenamePair(t *testing.T) {
+	// mv /tmp/old /tmp/new where /tmp/new already exists.
+	msgs := parseLogLines(t, `
+type=SYSCALL msg=audit(1611352600.301:1602): arch=c000003e syscall=82 success=yes exit=0 a0=7ffd2c1e9f12 a1=7ffd2c1e9f1b a2=0 a3=0 items=5 ppid=1500 pid=1602 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="mv" exe="/usr/bin/mv" key="tmp"
+type=CWD msg=audit(1611352600.301:1602): cwd="/home/vagrant"
+type=PATH msg=audit(1611352600.301:1602): item=0 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352600.301:1602): item=1 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352600.301:1602): item=2 name="/tmp/old" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=DELETE
+type=PATH msg=audit(1611352600.301:1602): item=3 name="/tmp/new" inode=1311 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=DELETE
+type=PATH msg=audit(1611352600.301:1602): item=4 name="/tmp/new" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=CREATE
+`)
+
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pair := event.RenamePair()
+	if assert.NotNil(t, pair) {
+		assert.Equal(t, 2, pair.From.Item)
+		assert.Equal(t, "/tmp/old", pair.From.Name)
+		assert.Equal(t, 4, pair.To.Item)
+		assert.Equal(t, "/tmp/new", pair.To.Name)
+	}
+
+	// A hard link pairs the new name with the existing file.
+	msgs = parseLogLines(t, `
+type=SYSCALL msg=audit(1611352600.401:1603): arch=c000003e syscall=86 success=yes exit=0 a0=7ffd2c1e9f12 a1=7ffd2c1e9f1b a2=0 a3=0 items=3 ppid=1500 pid=1603 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="ln" exe="/usr/bin/ln" key="tmp"
+type=PATH msg=audit(1611352600.401:1603): item=0 name="/tmp/new" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=NORMAL
+type=PATH msg=audit(1611352600.401:1603): item=1 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352600.401:1603): item=2 name="/tmp/link" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=CREATE
+`)
+
+	event, err = CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pair = event.RenamePair()
+	if assert.NotNil(t, pair) {
+		assert.Equal(t, "/tmp/new", pair.From.Name)
+		assert.Equal(t, "/tmp/link", pair.To.Name)
+	}
+
+	assert.Nil(t, (&Event{}).RenamePair())
+}
+
+func TestEventHardlink(t *testing.T) {
+	// ln /etc/shadow /tmp/shadow
+	msgs := parseLogLines(t, `
+type=SYSCALL msg=audit(1611352600.501:1604): arch=c000003e syscall=265 success=yes exit=0 a0=ffffff9c a1=7ffd2c1e9f12 a2=ffffff9c a3=7ffd2c1e9f1e items=3 ppid=1500 pid=1604 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="ln" exe="/usr/bin/ln" key="tmp"
+type=PATH msg=audit(1611352600.501:1604): item=0 name="/etc/shadow" inode=3436 dev=08:01 mode=0100640 ouid=0 ogid=42 rdev=00:00 nametype=NORMAL
+type=PATH msg=audit(1611352600.501:1604): item=1 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352600.501:1604): item=2 name="/tmp/shadow" inode=3436 dev=08:01 mode=0100640 ouid=0 ogid=42 rdev=00:00 nametype=CREATE
+`)
+
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	link := event.Hardlink()
+	if assert.NotNil(t, link) {
+		assert.Equal(t, "/etc/shadow", link.From.Name)
+		assert.Equal(t, "/tmp/shadow", link.To.Name)
+		assert.Equal(t, link.From.Inode, link.To.Inode)
+	}
+
+	// A rename shares the inode too but is not a hard link.
+	msgs = parseLogLines(t, `
+type=SYSCALL msg=audit(1611352600.301:1601): arch=c000003e syscall=82 success=yes exit=0 a0=7ffd2c1e9f12 a1=7ffd2c1e9f1b a2=0 a3=0 items=4 ppid=1500 pid=1601 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="mv" exe="/usr/bin/mv" key="tmp"
+type=PATH msg=audit(1611352600.301:1601): item=0 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352600.301:1601): item=1 name="/tmp/" inode=2 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+type=PATH msg=audit(1611352600.301:1601): item=2 name="/tmp/old" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=DELETE
+type=PATH msg=audit(1611352600.301:1601): item=3 name="/tmp/new" inode=1310 dev=08:01 mode=0100644 ouid=1000 ogid=1000 rdev=00:00 nametype=CREATE
+`)
+
+	event, err = CoalesceMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, event.Hardlink())
+
+	assert.Nil(t, (&Event{}).Hardlink())
+}
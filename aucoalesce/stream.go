@@ -0,0 +1,75 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	libaudit "github.com/elastic/go-libaudit/v2"
+	"github.com/elastic/go-libaudit/v2/auparse"
+)
+
+// StreamEvents reads raw audit messages, as received from the kernel, from in
+// and sends the events that they make up to out. Related messages are grouped
+// with a libaudit.Reassembler configured with maxInFlight and timeout, and are
+// then combined by CoalesceMessages. Messages that cannot be parsed and groups
+// that cannot be coalesced are dropped. StreamEvents returns when in is
+// closed, after the events that are still buffered have been sent. out is not
+// closed.
+func StreamEvents(in <-chan libaudit.RawAuditMessage, out chan<- *Event, maxInFlight int, timeout time.Duration) error {
+	if timeout <= 0 {
+		return errors.New("timeout must be greater than zero")
+	}
+
+	reassembler, err := libaudit.NewReassembler(maxInFlight, timeout, eventStream(out))
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(timeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case msg, ok := <-in:
+			if !ok {
+				return reassembler.Close()
+			}
+			// Push copies the data so the caller may reuse its buffer.
+			reassembler.Push(msg.Type, msg.Data)
+		case <-ticker.C:
+			reassembler.Maintain()
+		}
+	}
+}
+
+// eventStream is a libaudit.Stream that coalesces reassembled messages and
+// sends the resulting events to a channel.
+type eventStream chan<- *Event
+
+func (s eventStream) ReassemblyComplete(msgs []auparse.AuditMessage) {
+	event, err := CoalesceMessages(msgs)
+	if err != nil {
+		return
+	}
+	s <- event
+}
+
+func (s eventStream) EventsLost(count int) {}
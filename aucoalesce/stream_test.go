@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	libaudit "github.com/elastic/go-libaudit/v2"
+	"github.com/elastic/go-libaudit/v2/auparse"
+)
+
+func TestStreamEvents(t *testing.T) {
+	raw := []libaudit.RawAuditMessage{
+		{Type: auparse.AUDIT_SYSCALL, Data: []byte(`audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=241 a3=1b6 items=1 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="tee" exe="/usr/bin/tee" key="hosts"`)},
+		{Type: auparse.AUDIT_SYSCALL, Data: []byte(`audit(1611352422.105:1446): arch=c000003e syscall=80 success=yes exit=0 a0=7ffd1a2b a1=0 a2=0 a3=0 items=1 ppid=1500 pid=1533 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="bash" exe="/usr/bin/bash" key=(null)`)},
+		{Type: auparse.AUDIT_CWD, Data: []byte(`audit(1611352422.102:1445): cwd="/root"`)},
+		{Type: auparse.AUDIT_CWD, Data: []byte(`audit(1611352422.105:1446): cwd="/root"`)},
+		{Type: auparse.AUDIT_PATH, Data: []byte(`audit(1611352422.105:1446): item=0 name="/tmp" inode=131073 dev=08:01 mode=041777 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL`)},
+		{Type: auparse.AUDIT_PATH, Data: []byte(`audit(1611352422.102:1445): item=0 name="/etc/hosts" inode=131090 dev=08:01 mode=0100644 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL`)},
+		{Type: auparse.AUDIT_EOE, Data: []byte(`audit(1611352422.105:1446): `)},
+		{Type: auparse.AUDIT_EOE, Data: []byte(`audit(1611352422.102:1445): `)},
+	}
+
+	in := make(chan libaudit.RawAuditMessage)
+	out := make(chan *Event)
+	done := make(chan error, 1)
+	go func() {
+		done <- StreamEvents(in, out, 10, time.Second)
+		close(out)
+	}()
+	go func() {
+		for _, msg := range raw {
+			in <- msg
+		}
+		close(in)
+	}()
+
+	var events []*Event
+	for event := range out {
+		events = append(events, event)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, events, 2) {
+		assert.EqualValues(t, 1445, events[0].Sequence)
+		assert.Equal(t, "openat", events[0].Data["syscall"])
+		assert.Equal(t, "/etc/hosts", events[0].File.Path)
+		assert.EqualValues(t, 1446, events[1].Sequence)
+		assert.Equal(t, "chdir", events[1].Data["syscall"])
+		assert.Equal(t, "/tmp", events[1].Paths[0]["name"])
+	}
+}
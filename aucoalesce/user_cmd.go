@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/go-libaudit/v2/auparse"
+)
+
+// UserCommand describes a command that was run via sudo or su.
+type UserCommand struct {
+	Command string `json:"command"           yaml:"command"`
+	RunUser string `json:"runuser,omitempty" yaml:"runuser,omitempty"` // User the command ran as.
+	CWD     string `json:"cwd,omitempty"     yaml:"cwd,omitempty"`
+}
+
+// GetUserCommand builds a UserCommand from a group of messages that contains
+// a USER_CMD record. The command and working directory come from the USER_CMD
+// record. If the group also contains the SYSCALL, EXECVE, and CWD records of
+// the executed command then the effective user of the SYSCALL is used as the
+// run user and the EXECVE and CWD records fill in any missing values. An error
+// is returned if no USER_CMD record is present.
+func GetUserCommand(msgs []auparse.AuditMessage) (*UserCommand, error) {
+	var userCmd, syscall, execve, cwd *auparse.AuditMessage
+	for i := range msgs {
+		switch msgs[i].RecordType {
+		case auparse.AUDIT_USER_CMD:
+			userCmd = &msgs[i]
+		case auparse.AUDIT_SYSCALL:
+			syscall = &msgs[i]
+		case auparse.AUDIT_EXECVE:
+			execve = &msgs[i]
+		case auparse.AUDIT_CWD:
+			cwd = &msgs[i]
+		}
+	}
+	if userCmd == nil {
+		return nil, errors.New("USER_CMD message not found")
+	}
+
+	data, err := userCmd.Data()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse USER_CMD message")
+	}
+
+	cmd := &UserCommand{
+		Command: data["cmd"],
+		RunUser: data["acct"],
+		CWD:     data["cwd"],
+	}
+
+	if syscall != nil {
+		if data, err := syscall.Data(); err == nil {
+			if euid, found := data["euid"]; found {
+				cmd.RunUser = euid
+			}
+		}
+	}
+
+	if cmd.CWD == "" && cwd != nil {
+		if data, err := cwd.Data(); err == nil {
+			cmd.CWD = data["cwd"]
+		}
+	}
+
+	if cmd.Command == "" && execve != nil {
+		if args, err := execve.Execve(); err == nil {
+			cmd.Command = strings.Join(args, " ")
+		}
+	}
+
+	return cmd, nil
+}
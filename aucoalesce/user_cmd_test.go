@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aucoalesce
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/go-libaudit/v2/auparse"
+)
+
+func parseLogLines(t testing.TB, lines string) []auparse.AuditMessage {
+	var msgs []auparse.AuditMessage
+	s := bufio.NewScanner(strings.NewReader(lines))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		msg, err := auparse.ParseLogLine(line)
+		if err != nil {
+			t.Fatal("invalid message:", line)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+const sudoEvent = `
+type=USER_CMD msg=audit(1611348034.951:1305): pid=31336 uid=1000 auid=1000 ses=3 msg='cwd="/home/vagrant" cmd=6C73202D6C61202F726F6F74 exe="/usr/bin/sudo" terminal=pts/0 res=success'
+type=SYSCALL msg=audit(1611348034.955:1309): arch=c000003e syscall=59 success=yes exit=0 a0=55d0b4f0e8d8 a1=55d0b4f0e8e8 a2=55d0b4ef2080 a3=0 items=2 ppid=31336 pid=31337 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=3 comm="ls" exe="/usr/bin/ls" key=(null)
+type=EXECVE msg=audit(1611348034.955:1309): argc=3 a0="ls" a1="-la" a2="/root"
+type=CWD msg=audit(1611348034.955:1309): cwd="/home/vagrant"
+`
+
+func TestGetUserCommand(t *testing.T) {
+	t.Run("sudo", func(t *testing.T) {
+		cmd, err := GetUserCommand(parseLogLines(t, sudoEvent))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, &UserCommand{
+			Command: "ls -la /root",
+			RunUser: "0",
+			CWD:     "/home/vagrant",
+		}, cmd)
+	})
+
+	t.Run("su", func(t *testing.T) {
+		msgs := parseLogLines(t, `type=USER_CMD msg=audit(1493162652.304:18662): pid=9729 uid=1001 auid=1001 ses=1 msg='cwd="/home/andrew_kroh" cmd="su" terminal=pts/0 res=success'`)
+		cmd, err := GetUserCommand(msgs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, &UserCommand{
+			Command: "su",
+			CWD:     "/home/andrew_kroh",
+		}, cmd)
+	})
+
+	t.Run("no_user_cmd", func(t *testing.T) {
+		msgs := parseLogLines(t, sudoEvent)
+		_, err := GetUserCommand(msgs[1:])
+		assert.Error(t, err)
+	})
+}
@@ -31,7 +31,7 @@ func asset(key string) ([]byte, error) {
 		assets = map[string][]byte{}
 
 		var value []byte
-		value, _ = base64.StdEncoding.DecodeString("LS0tCiMgTWFjcm9zIGRlY2xhcmVzIHNvbWUgWUFNTCBhbmNob3JzIHRoYXQgY2FuIGJlIHJlZmVyZW5jZWQgZm9yIHNvbWUgY29tbW9uCiMgb2JqZWN0IHR5cGUgbm9ybWFsaXphdGlvbnMgbGlrZSB1c2VyLXNlc3Npb24sIHNvY2tldCwgb3IgcHJvY2Vzcy4KbWFjcm9zOgogIC0gJmRlZmF1bHRzCiAgICBzdWJqZWN0OgogICAgICBwcmltYXJ5OiBhdWlkCiAgICAgIHNlY29uZGFyeTogdWlkCiAgICBob3c6IFtleGUsIGNvbW1dCgogIC0gJm1hY3JvLXVzZXItc2Vzc2lvbgogICAgc3ViamVjdDoKICAgICAgcHJpbWFyeTogYXVpZAogICAgICBzZWNvbmRhcnk6IFthY2N0LCBpZCwgdWlkXQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiB0ZXJtaW5hbAogICAgICBzZWNvbmRhcnk6IFthZGRyLCBob3N0bmFtZV0KICAgICAgd2hhdDogdXNlci1zZXNzaW9uCiAgICBob3c6IFtleGUsIHRlcm1pbmFsXQoKICAtICZtYWNyby1zb2NrZXQKICAgIDw8OiAqZGVmYXVsdHMKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogW2FkZHIsIHBhdGhdCiAgICAgIHNlY29uZGFyeTogcG9ydAogICAgICB3aGF0OiBzb2NrZXQKCiAgLSAmbWFjcm8tcHJvY2VzcwogICAgPDw6ICpkZWZhdWx0cwogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbY21kLCBleGUsIGNvbW1dCiAgICAgIHNlY29uZGFyeTogcGlkCiAgICAgIHdoYXQ6IHByb2Nlc3MKICAgIGhvdzogdGVybWluYWwKCiAgLSAmZWNzLWlhbQogICAgY2F0ZWdvcnk6IGlhbQogICAgdHlwZTogaW5mbwoKICAtICZlY3MtYXV0aAogICAgY2F0ZWdvcnk6IGF1dGhlbnRpY2F0aW9uCiAgICB0eXBlOiBpbmZvCiAgICBtYXBwaW5nczoKICAgICAgLSBmcm9tOiBzdWJqZWN0LnByaW1hcnkKICAgICAgICB0bzogdXNlcgogICAgICAtIGZyb206IHN1YmplY3Quc2Vjb25kYXJ5CiAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCgogIC0gJmVjcy1zZXNzaW9uCiAgICBjYXRlZ29yeTogc2Vzc2lvbgogICAgdHlwZTogaW5mbwogICAgbWFwcGluZ3M6CiAgICAgIC0gZnJvbTogc3ViamVjdC5wcmltYXJ5CiAgICAgICAgdG86IHVzZXIKICAgICAgLSBmcm9tOiBzdWJqZWN0LnNlY29uZGFyeQogICAgICAgIHRvOiB1c2VyLmVmZmVjdGl2ZQoKICAtICZlY3MtaG9zdAogICAgY2F0ZWdvcnk6IGhvc3QKICAgIHR5cGU6IGluZm8KCiAgLSAmZWNzLXByb2Nlc3MKICAgIGNhdGVnb3J5OiBwcm9jZXNzCiAgICB0eXBlOiBpbmZvCgogIC0gJmVjcy1maWxlCiAgICBjYXRlZ29yeTogZmlsZQogICAgdHlwZTogaW5mbwoKICAtICZlY3MtZHJpdmVyCiAgICBjYXRlZ29yeTogZHJpdmVyCiAgICB0eXBlOiBpbmZvCgogIC0gJmVjcy1uZXR3b3JrCiAgICBjYXRlZ29yeTogbmV0d29yawogICAgdHlwZToKICAgICAgLSBjb25uZWN0aW9uCiAgICAgIC0gaW5mbwoKICAtICZlY3MtdXNlci1tb2RpZmljYXRpb24tbWFwcGluZ3MKICAgIG1hcHBpbmdzOgogICAgICAtIGZyb206IHN1YmplY3QucHJpbWFyeQogICAgICAgIHRvOiB1c2VyCiAgICAgIC0gZnJvbTogc3ViamVjdC5zZWNvbmRhcnkKICAgICAgICB0bzogdXNlci5lZmZlY3RpdmUKICAgICAgLSBmcm9tOiBvYmplY3QucHJpbWFyeQogICAgICAgIHRvOiB1c2VyLnRhcmdldAoKICAtICZlY3MtZ3JvdXAtbW9kaWZpY2F0aW9uLW1hcHBpbmdzCiAgICBtYXBwaW5nczoKICAgICAgLSBmcm9tOiBzdWJqZWN0LnByaW1hcnkKICAgICAgICB0bzogdXNlcgogICAgICAtIGZyb206IHN1YmplY3Quc2Vjb25kYXJ5CiAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCiAgICAgIC0gZnJvbTogb2JqZWN0LnByaW1hcnkKICAgICAgICB0bzogZ3JvdXAKCiMgTm9ybWFsaXphdGlvbnMgaXMgYSBsaXN0IG9mIGRlY2xhcmF0aW9ucyBzcGVjaWZ5aW5nIGhvdyB0byBub3JtYWxpemUgdGhlIGRhdGEKIyBjb250YWluZWQgaW4gYW4gZXZlbnQuIFRoZSBub3JtYWxpemF0aW9uIGNhbiBiZSBhcHBsaWVkIGJhc2VkIG9uIHRoZSBzeXNjYWxsCiMgbmFtZSAoZS5nLiBjb25uZWN0LCBvcGVuKSBvciBiYXNlZCBvbiB0aGUgcmVjb3JkIHR5cGUgKGUuZy4gVVNFUl9MT0dJTikuCiMgTm8gdHdvIG5vcm1hbGl6YXRpb25zIGNhbiBhcHBseSB0byB0aGUgc2FtZSBzeXNjYWxsIG9yIHJlY29yZCB0eXBlLiBUaGlzCiMgd2lsbCByZXN1bHQgaW4gYSBmYWlsdXJlIGF0IGxvYWQgdGltZS4KIwojIEVhY2ggbm9ybWFsaXphdGlvbiBzaG91bGQgc3BlY2lmeToKIyAgIGFjdGlvbiAtIHdoYXQgaGFwcGVuZWQKIyAgIGFjdG9yICAtIHdobyBkaWQgdGhpcyBvciB3aG8gdHJpZ2dlcmVkIHRoZSBldmVudAojICAgb2JqZWN0IC0gd2hhdCB3YXMgdGhlICJ0aGluZyIgaW52b2x2ZWQgaW4gdGhlIGFjdGlvbiAoZS5nLiBwcm9jZXNzLCBzb2NrZXQpCiMgICBob3cgICAgLSBob3cgd2FzIHRoZSBhY3Rpb24gcGVyZm9ybWVkIChlLmcuIGV4ZSBvciB0ZXJtaW5hbCkKbm9ybWFsaXphdGlvbnM6CiAgLSBlY3M6ICplY3MtcHJvY2VzcwogICAgc3lzY2FsbHM6CiAgICAgIC0gJyonICMgdGhpcyBpcyBhIGNhdGNoIGFsbAogIC0gYWN0aW9uOiBvcGVuZWQtZmlsZQogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBjcmVhdCAtIG9wZW4gYW5kIHBvc3NpYmx5IGNyZWF0ZSBhIGZpbGUKICAgICAgLSBjcmVhdAogICAgZWNzOgogICAgICA8PDogKmVjcy1maWxlCiAgICAgIHR5cGU6IGNyZWF0aW9uCiAgLSBhY3Rpb246IG9wZW5lZC1maWxlCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIGZhbGxvY2F0ZSAtIG1hbmlwdWxhdGUgZmlsZSBzcGFjZQogICAgICAtIGZhbGxvY2F0ZQogICAgICAjIHRydW5jYXRlIC0gdHJ1bmNhdGUgYSBmaWxlIHRvIGEgc3BlY2lmaWVkIGxlbmd0aAogICAgICAtIHRydW5jYXRlCiAgICAgICMgZnRydW5jYXRlIC0gdHJ1bmNhdGUgYSBmaWxlIHRvIGEgc3BlY2lmaWVkIGxlbmd0aAogICAgICAtIGZ0cnVuY2F0ZQogICAgZWNzOgogICAgICA8PDogKmVjcy1maWxlCiAgICAgICMgdGVjaG5pY2FsbHkgeW91IGNhbiB0cnVuY2F0ZSBhIGZpbGUgdG8gdGhlIHNhbWUgbGVuZ3RoCiAgICAgICMgYnV0IHJlZ2FyZGxlc3MsIHdlIGNvbnNpZGVyIHRoaXMgYSBjaGFuZ2UKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IG9wZW5lZC1maWxlCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIG9wZW4gLSBvcGVuIGFuZCBwb3NzaWJseSBjcmVhdGUgYSBmaWxlCiAgICAgIC0gb3BlbgogICAgICAjIG9wZW5hdCAtIG9wZW4gYW5kIHBvc3NpYmx5IGNyZWF0ZSBhIGZpbGUKICAgICAgLSBvcGVuYXQKICAgICAgIyByZWFkbGluayAtIHJlYWQgdmFsdWUgb2YgYSBzeW1ib2xpYyBsaW5rCiAgICAgIC0gcmVhZGxpbmsKICAgICAgIyByZWFkbGlua2F0IC0gcmVhZCB2YWx1ZSBvZiBhIHN5bWJvbGljIGxpbmsKICAgICAgLSByZWFkbGlua2F0CiAgICBlY3M6ICplY3MtZmlsZQogIC0gYWN0aW9uOiByZWFkLWZpbGUKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgc3lzY2FsbHM6CiAgICAgICMgcmVhZCAtIHJlYWQgZnJvbSBhIGZpbGUgZGVzY3JpcHRvcgogICAgICAtIHJlYWQKICAgIGVjczogKmVjcy1maWxlCiAgLSBhY3Rpb246IHdyb3RlLXRvLWZpbGUKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgc3lzY2FsbHM6CiAgICAgICMgd3JpdGUgLSB3cml0ZSB0byBhIGZpbGUgZGVzY3JpcHRvcgogICAgICAtIHdyaXRlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IGNoYW5nZWQtZmlsZS1hdHRyaWJ1dGVzLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIHNldHhhdHRyIC0gc2V0IGFuIGV4dGVuZGVkIGF0dHJpYnV0ZSB2YWx1ZQogICAgICAtIHNldHhhdHRyCiAgICAgICMgZnNldHhhdHRyIC0gc2V0IGFuIGV4dGVuZGVkIGF0dHJpYnV0ZSB2YWx1ZQogICAgICAtIGZzZXR4YXR0cgogICAgICAjIGxzZXR4YXR0ciAtIHNldCBhbiBleHRlbmRlZCBhdHRyaWJ1dGUgdmFsdWUKICAgICAgLSBsc2V0eGF0dHIKICAgICAgIyByZW1vdmV4YXR0ciAtIHJlbW92ZSBhbiBleHRlbmRlZCBhdHRyaWJ1dGUKICAgICAgLSByZW1vdmV4YXR0cgogICAgICAjIGZyZW1vdmV4YXR0ciAtIHJlbW92ZSBhbiBleHRlbmRlZCBhdHRyaWJ1dGUKICAgICAgLSBmcmVtb3ZleGF0dHIKICAgICAgIyBscmVtb3ZleGF0dHIgLSByZW1vdmUgYW4gZXh0ZW5kZWQgYXR0cmlidXRlCiAgICAgIC0gbHJlbW92ZXhhdHRyCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IGNoYW5nZWQtZmlsZS1wZXJtaXNzaW9ucy1vZgogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBjaG1vZCAtIGNoYW5nZSBwZXJtaXNzaW9ucyBvZiBhIGZpbGUKICAgICAgLSBjaG1vZAogICAgICAjIGZjaG1vZCAtIGNoYW5nZSBwZXJtaXNzaW9ucyBvZiBhIGZpbGUKICAgICAgLSBmY2htb2QKICAgICAgIyBmY2htb2RhdCAtIGNoYW5nZSBwZXJtaXNzaW9ucyBvZiBhIGZpbGUKICAgICAgLSBmY2htb2RhdAogICAgZWNzOgogICAgICA8PDogKmVjcy1maWxlCiAgICAgIHR5cGU6IGNoYW5nZQogIC0gYWN0aW9uOiBjaGFuZ2VkLWZpbGUtb3duZXJzaGlwLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIGNob3duIC0gY2hhbmdlIG93bmVyc2hpcCBvZiBhIGZpbGUKICAgICAgLSBjaG93bgogICAgICAjIGZjaG93biAtIGNoYW5nZSBvd25lcnNoaXAgb2YgYSBmaWxlCiAgICAgIC0gZmNob3duCiAgICAgICMgZmNob3duYXQgLSBjaGFuZ2Ugb3duZXJzaGlwIG9mIGEgZmlsZQogICAgICAtIGZjaG93bmF0CiAgICAgICMgbGNob3duIC0gY2hhbmdlIG93bmVyc2hpcCBvZiBhIGZpbGUKICAgICAgLSBsY2hvd24KICAgIGVjczoKICAgICAgPDw6ICplY3MtZmlsZQogICAgICB0eXBlOiBjaGFuZ2UKICAtIGFjdGlvbjogbG9hZGVkLWtlcm5lbC1tb2R1bGUKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgICBwcmltYXJ5OiBuYW1lCiAgICByZWNvcmRfdHlwZXM6CiAgICAgIC0gS0VSTl9NT0RVTEUKICAgIHN5c2NhbGxzOgogICAgICAjIGZpbml0X21vZHVsZSAtIGxvYWQgYSBrZXJuZWwgbW9kdWxlCiAgICAgIC0gZmluaXRfbW9kdWxlCiAgICAgICMgaW5pdF9tb2R1bGUgLSBsb2FkIGEga2VybmVsIG1vZHVsZQogICAgICAtIGluaXRfbW9kdWxlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWRyaXZlcgogICAgICB0eXBlOiBzdGFydAogIC0gYWN0aW9uOiB1bmxvYWRlZC1rZXJuZWwtbW9kdWxlCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIGRlbGV0ZV9tb2R1bGUgLSB1bmxvYWQgYSBrZXJuZWwgbW9kdWxlCiAgICAgIC0gZGVsZXRlX21vZHVsZQogICAgZWNzOgogICAgICA8PDogKmVjcy1kcml2ZXIKICAgICAgdHlwZTogZW5kCiAgLSBhY3Rpb246IGNyZWF0ZWQtZGlyZWN0b3J5CiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgICAgcGF0aF9pbmRleDogMQogICAgc3lzY2FsbHM6CiAgICAgICMgbWtkaXIgLSBjcmVhdGUgYSBkaXJlY3RvcnkKICAgICAgLSBta2RpcgogICAgICAjIG1rZGlyYXQgLSBjcmVhdGUgYSBkaXJlY3RvcnkKICAgICAgLSBta2RpcmF0CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgdHlwZTogY3JlYXRpb24KICAtIGFjdGlvbjogbW91bnRlZAogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlc3lzdGVtCiAgICAgIHBhdGhfaW5kZXg6IDEKICAgIHN5c2NhbGxzOgogICAgICAjIG1vdW50IC0gbW91bnQgZmlsZXN5c3RlbQogICAgICAtIG1vdW50CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgIyBzaW5jZSBhIG5ldyBtb3VudCBhcHBlYXJzIG9uIHRoZSBzeXN0ZW0KICAgICAgIyB3ZSBjb25zaWRlciB0aGlzIGEgaGlnaC1sZXZlbCAiY3JlYXRpb24iIGV2ZW50CiAgICAgIHR5cGU6IGNyZWF0aW9uCiAgLSBhY3Rpb246IHJlbmFtZWQKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgICBwYXRoX2luZGV4OiAyCiAgICBzeXNjYWxsczoKICAgICAgIyByZW5hbWUgLSBjaGFuZ2UgdGhlIG5hbWUgb3IgbG9jYXRpb24gb2YgYSBmaWxlCiAgICAgIC0gcmVuYW1lCiAgICAgICMgcmVuYW1lYXQgLSBjaGFuZ2UgdGhlIG5hbWUgb3IgbG9jYXRpb24gb2YgYSBmaWxlCiAgICAgIC0gcmVuYW1lYXQKICAgICAgIyByZW5hbWVhdDIgLSBjaGFuZ2UgdGhlIG5hbWUgb3IgbG9jYXRpb24gb2YgYSBmaWxlCiAgICAgIC0gcmVuYW1lYXQyCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IGNoZWNrZWQtbWV0YWRhdGEtb2YKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgc3lzY2FsbHM6CiAgICAgICMgYWNjZXNzIC0gY2hlY2sgdXNlcidzIHBlcm1pc3Npb25zIGZvciBhIGZpbGUKICAgICAgLSBhY2Nlc3MKICAgICAgIyBmYWNjZXNzYXQgLSBjaGVjayB1c2VyJ3MgcGVybWlzc2lvbnMgZm9yIGEgZmlsZQogICAgICAtIGZhY2Nlc3NhdAogICAgICAjIGZzdGF0YXQgLSBnZXQgZmlsZSBzdGF0dXMKICAgICAgLSBmc3RhdGF0CiAgICAgICMgbmV3ZnN0YXRhdCAtIGdldCBmaWxlIHN0YXR1cwogICAgICAtIG5ld2ZzdGF0YXQKICAgICAgIyBzdGF0IC0gZ2V0IGZpbGUgc3RhdHVzCiAgICAgIC0gc3RhdAogICAgICAjIHN0YXQ2NCAtIGdldCBmaWxlIHN0YXR1cwogICAgICAtIHN0YXQ2NAogICAgICAjIGZzdGF0IC0gZ2V0IGZpbGUgc3RhdHVzCiAgICAgIC0gZnN0YXQKICAgICAgIyBsc3RhdCAtIGdldCBmaWxlIHN0YXR1cwogICAgICAtIGxzdGF0CiAgICAgICMgZ2V0eGF0dHIgLSByZXRyaWV2ZSBhbiBleHRlbmRlZCBhdHRyaWJ1dGUgdmFsdWUKICAgICAgLSBnZXR4YXR0cgogICAgICAjIGxnZXR4YXR0ciAtIHJldHJpZXZlIGFuIGV4dGVuZGVkIGF0dHJpYnV0ZSB2YWx1ZQogICAgICAtIGxnZXR4YXR0cgogICAgICAjIGZnZXR4YXR0ciAtIHJldHJpZXZlIGFuIGV4dGVuZGVkIGF0dHJpYnV0ZSB2YWx1ZQogICAgICAtIGZnZXR4YXR0cgogICAgZWNzOiAqZWNzLWZpbGUKICAtIGFjdGlvbjogY2hlY2tlZC1maWxlc3lzdGVtLW1ldGFkYXRhLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGVzeXN0ZW0KICAgIHN5c2NhbGxzOgogICAgICAjIHN0YXRmcyAtIGdldCBmaWxlc3lzdGVtIHN0YXRpc3RpY3MKICAgICAgLSBzdGF0ZnMKICAgICAgIyBmc3RhdGZzIC0gZ2V0IGZpbGVzeXN0ZW0gc3RhdGlzdGljcwogICAgICAtIGZzdGF0ZnMKICAgIGVjczogKmVjcy1maWxlCiAgLSBhY3Rpb246IHN5bWxpbmtlZAogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBzeW1saW5rIC0gbWFrZSBhIG5ldyBuYW1lIGZvciBhIGZpbGUKICAgICAgLSBzeW1saW5rCiAgICAgICMgc3ltbGlua2F0IC0gbWFrZSBhIG5ldyBuYW1lIGZvciBhIGZpbGUKICAgICAgLSBzeW1saW5rYXQKICAgIGVjczoKICAgICAgPDw6ICplY3MtZmlsZQogICAgICAjICJjcmVhdGlvbiIgc2luY2Ugd2UncmUgY3JlYXRpbmcgYSBuZXcgZmlsZSBzeXN0ZW0KICAgICAgIyBlbnRyeSBmb3IgdGhlIHN5bWxpbmsKICAgICAgdHlwZTogY3JlYXRpb24KICAtIGFjdGlvbjogdW5tb3VudGVkCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGVzeXN0ZW0KICAgIHN5c2NhbGxzOgogICAgICAjIHVtb3VudCAtIHVubW91bnQgZmlsZXN5c3RlbQogICAgICAtIHVtb3VudAogICAgICAjIHVtb3VudDIgLSB1bm1vdW50IGZpbGVzeXN0ZW0KICAgICAgLSB1bW91bnQyCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgIyAiZGVsZXRpb24iIHRvIG1pcnJvciB0aGUgImNyZWF0aW9uIiBvZiB0aGUgbW91bnQKICAgICAgdHlwZTogZGVsZXRpb24KICAtIGFjdGlvbjogZGVsZXRlZAogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBybWRpciAtIGRlbGV0ZSBhIGRpcmVjdG9yeQogICAgICAtIHJtZGlyCiAgICAgICMgdW5saW5rIC0gZGVsZXRlIGEgbmFtZSBhbmQgcG9zc2libHkgdGhlIGZpbGUgaXQgcmVmZXJzIHRvCiAgICAgIC0gdW5saW5rCiAgICAgICMgdW5saW5rYXQgLSBkZWxldGUgYSBuYW1lIGFuZCBwb3NzaWJseSB0aGUgZmlsZSBpdCByZWZlcnMgdG8KICAgICAgLSB1bmxpbmthdAogICAgZWNzOgogICAgICA8PDogKmVjcy1maWxlCiAgICAgIHR5cGU6IGRlbGV0aW9uCiAgLSBhY3Rpb246IGNoYW5nZWQtdGltZXN0YW1wLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIHV0aW1lIC0gY2hhbmdlIGZpbGUgbGFzdCBhY2Nlc3MgYW5kIG1vZGlmaWNhdGlvbiB0aW1lcwogICAgICAtIHV0aW1lCiAgICAgICMgdXRpbWVzIC0gY2hhbmdlIGZpbGUgbGFzdCBhY2Nlc3MgYW5kIG1vZGlmaWNhdGlvbiB0aW1lcwogICAgICAtIHV0aW1lcwogICAgICAjIGZ1dGltZXNhdCAtIGNoYW5nZSB0aW1lc3RhbXBzIG9mIGEgZmlsZSByZWxhdGl2ZSB0byBhIFwgZGlyZWN0b3J5IGZpbGUgZGVzY3JpcHRvcgogICAgICAtIGZ1dGltZXNhdAogICAgICAjIGZ1dGltZW5zIC0gY2hhbmdlIGZpbGUgdGltZXN0YW1wcyB3aXRoIG5hbm9zZWNvbmQgcHJlY2lzaW9uCiAgICAgIC0gZnV0aW1lbnMKICAgICAgIyB1dGltZW5zYXQgLSBjaGFuZ2UgZmlsZSB0aW1lc3RhbXBzIHdpdGggbmFub3NlY29uZCBwcmVjaXNpb24KICAgICAgLSB1dGltZW5zYXQKICAgIGVjczogKmVjcy1maWxlCiAgLSBhY3Rpb246IGV4ZWN1dGVkCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIGV4ZWN2ZSAtIGV4ZWN1dGUgcHJvZ3JhbQogICAgICAtIGV4ZWN2ZQogICAgICAjIGV4ZWN2ZWF0IC0gZXhlY3V0ZSBwcm9ncmFtIHJlbGF0aXZlIHRvIGEgZGlyZWN0b3J5IGZpbGUgZGVzY3JpcHRvcgogICAgICAtIGV4ZWN2ZWF0CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogc3RhcnQKICAtIGFjdGlvbjogbGlzdGVuLWZvci1jb25uZWN0aW9ucwogICAgb2JqZWN0OgogICAgICB3aGF0OiBzb2NrZXQKICAgIHN5c2NhbGxzOgogICAgICAjIGxpc3Rlbi0gbGlzdGVuIGZvciBjb25uZWN0aW9ucyBvbiBhIHNvY2tldAogICAgICAtIGxpc3RlbgogICAgZWNzOgogICAgICA8PDogKmVjcy1uZXR3b3JrCiAgICAgIHR5cGU6IHN0YXJ0CiAgLSBhY3Rpb246IGFjY2VwdGVkLWNvbm5lY3Rpb24tZnJvbQogICAgb2JqZWN0OgogICAgICB3aGF0OiBzb2NrZXQKICAgIHN5c2NhbGxzOgogICAgICAjIGFjY2VwdCAtIGFjY2VwdCBhIGNvbm5lY3Rpb24gb24gYSBzb2NrZXQKICAgICAgLSBhY2NlcHQKICAgICAgIyBhY2NlcHQ0IC0gYWNjZXB0IGEgY29ubmVjdGlvbiBvbiBhIHNvY2tldAogICAgICAtIGFjY2VwdDQKICAgIGVjczoKICAgICAgPDw6ICplY3MtbmV0d29yawogICAgICB0eXBlOgogICAgICAgIC0gY29ubmVjdGlvbgogICAgICAgIC0gc3RhcnQKICAtIGFjdGlvbjogYm91bmQtc29ja2V0CiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNvY2tldAogICAgc3lzY2FsbHM6CiAgICAgICMgYmluZCAtYmluZCBhIG5hbWUgdG8gYSBzb2NrZXQKICAgICAgLSBiaW5kCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLW5ldHdvcmsKICAgICAgdHlwZTogc3RhcnQKICAtIGFjdGlvbjogY29ubmVjdGVkLXRvCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNvY2tldAogICAgc3lzY2FsbHM6CiAgICAgIC0gY29ubmVjdAogICAgZWNzOgogICAgICA8PDogKmVjcy1uZXR3b3JrCiAgICAgIHR5cGU6CiAgICAgICAgLSBjb25uZWN0aW9uCiAgICAgICAgLSBzdGFydAogIC0gYWN0aW9uOiByZWNlaXZlZC1mcm9tCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNvY2tldAogICAgc3lzY2FsbHM6CiAgICAgICMgcmVjdiAtIHJlY2VpdmUgYSBtZXNzYWdlIGZyb20gYSBzb2NrZXQKICAgICAgLSByZWN2CiAgICAgICMgcmVjdmZyb20gLSByZWNlaXZlIGEgbWVzc2FnZSBmcm9tIGEgc29ja2V0CiAgICAgIC0gcmVjdmZyb20KICAgICAgIyByZWN2bXNnIC0gcmVjZWl2ZSBhIG1lc3NhZ2UgZnJvbSBhIHNvY2tldAogICAgICAtIHJlY3Ztc2cKICAgICAgIyByZWN2bW1zZyAtIHJlY2VpdmUgbXVsdGlwbGUgbWVzc2FnZXMgb24gYSBzb2NrZXQKICAgICAgLSByZWN2bW1zZwogICAgZWNzOgogICAgICA8PDogKmVjcy1uZXR3b3JrCiAgLSBhY3Rpb246IHNlbnQtdG8KICAgIG9iamVjdDoKICAgICAgd2hhdDogc29ja2V0CiAgICBzeXNjYWxsczoKICAgICAgIyBzZW5kIC0gc2VuZCBhIG1lc3NhZ2Ugb24gYSBzb2NrZXQKICAgICAgLSBzZW5kCiAgICAgICMgc2VuZHRvIC0gc2VuZCBhIG1lc3NhZ2Ugb24gYSBzb2NrZXQKICAgICAgLSBzZW5kdG8KICAgICAgIyBzZW5kbXNnIC0gc2VuZCBhIG1lc3NhZ2Ugb24gYSBzb2NrZXQKICAgICAgLSBzZW5kbXNnCiAgICAgICMgc2VuZG1tc2cgLSBzZW5kIG11bHRpcGxlIG1lc3NhZ2VzIG9uIGEgc29ja2V0CiAgICAgIC0gc2VuZG1tc2cKICAgIGVjczoKICAgICAgPDw6ICplY3MtbmV0d29yawogIC0gYWN0aW9uOiBraWxsZWQtcGlkCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHByb2Nlc3MKICAgIHN5c2NhbGxzOgogICAgICAjIGtpbGwgLSBzZW5kIHNpZ25hbCB0byBhIHByb2Nlc3MKICAgICAgLSBraWxsCiAgICAgICMgdGtpbGwgLSBzZW5kIGEgc2lnbmFsIHRvIGEgdGhyZWFkCiAgICAgIC0gdGtpbGwKICAgICAgIyB0Z2tpbGwgLSBzZW5kIGEgc2lnbmFsIHRvIGEgdGhyZWFkCiAgICAgIC0gdGdraWxsCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogZW5kCiAgLSBhY3Rpb246IGNoYW5nZWQtaWRlbnRpdHktb2YKICAgIG9iamVjdDoKICAgICAgd2hhdDogcHJvY2VzcwogICAgaG93OiBzeXNjYWxsCiAgICBzeXNjYWxsczoKICAgICAgIyBzZXR1aWQgLSBzZXQgdXNlciBpZGVudGl0eQogICAgICAtIHNldHVpZAogICAgICAjIHNldGV1aWQgLSBzZXQgZWZmZWN0aXZlIHVzZXIgb3IgZ3JvdXAgSUQKICAgICAgLSBzZXRldWlkCiAgICAgICMgc2V0ZnN1aWQgLSBzZXQgdXNlciBpZGVudGl0eSB1c2VkIGZvciBmaWxlc3lzdGVtIGNoZWNrcwogICAgICAtIHNldGZzdWlkCiAgICAgICMgc2V0cmV1aWQgLSBzZXQgcmVhbCBhbmQvb3IgZWZmZWN0aXZlIHVzZXIgb3IgZ3JvdXAgSUQKICAgICAgLSBzZXRyZXVpZAogICAgICAjIHNldGdpZCAtIHNldCBncm91cCBpZGVudGl0eQogICAgICAtIHNldGdpZAogICAgICAjIHNldGVnaWQgLSBzZXQgZWZmZWN0aXZlIHVzZXIgb3IgZ3JvdXAgSUQKICAgICAgLSBzZXRlZ2lkCiAgICAgICMgc2V0ZnNnaWQgLSBzZXQgZ3JvdXAgaWRlbnRpdHkgdXNlZCBmb3IgZmlsZXN5c3RlbSBjaGVja3MKICAgICAgLSBzZXRmc2dpZAogICAgICAjIHNldHJlZ2lkIC0gc2V0IHJlYWwgYW5kL29yIGVmZmVjdGl2ZSB1c2VyIG9yIGdyb3VwIElECiAgICAgIC0gc2V0cmVnaWQKICAgICAgIyBzZXRyZXN1aWQgLSBzZXQgcmVhbCwgZWZmZWN0aXZlIGFuZCBzYXZlZCB1c2VyIG9yIGdyb3VwIElECiAgICAgIC0gc2V0cmVzdWlkCiAgICAgICMgc2V0cmVzZ2lkIC0gc2V0IHJlYWwsIGVmZmVjdGl2ZSBhbmQgc2F2ZWQgdXNlciBvciBncm91cCBJRAogICAgICAtIHNldHJlc2dpZAogICAgZWNzOgogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IGNoYW5nZQogIC0gYWN0aW9uOiBjaGFuZ2VkLXN5c3RlbS10aW1lCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHN5c3RlbQogICAgc3lzY2FsbHM6CiAgICAgICMgc2V0dGltZW9mZGF5IC0gZ2V0IC8gc2V0IHRpbWUKICAgICAgLSBzZXR0aW1lb2ZkYXkKICAgICAgIyBjbG9ja19zZXR0aW1lIC0gY2xvY2sgYW5kIHRpbWUgZnVuY3Rpb25zCiAgICAgIC0gY2xvY2tfc2V0dGltZQogICAgICAjIHN0aW1lIC0gc2V0IHRpbWUKICAgICAgLSBzdGltZQogICAgICAjIGFkanRpbWV4IC0gdHVuZSBrZXJuZWwgY2xvY2sKICAgICAgLSBhZGp0aW1leAogICAgZWNzOgogICAgICA8PDogKmVjcy1ob3N0CiAgICAgIHR5cGU6IGNoYW5nZQogIC0gYWN0aW9uOiBtYWtlLWRldmljZQogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBta25vZCAtIGNyZWF0ZSBhIHNwZWNpYWwgb3Igb3JkaW5hcnkgZmlsZQogICAgICAtIG1rbm9kCiAgICAgICMgbWtub2RhdCAtIGNyZWF0ZSBhIHNwZWNpYWwgb3Igb3JkaW5hcnkgZmlsZQogICAgICAtIG1rbm9kYXQKICAgIGVjczoKICAgICAgPDw6ICplY3MtZmlsZQogICAgICB0eXBlOiBjcmVhdGlvbgogIC0gYWN0aW9uOiBjaGFuZ2VkLXN5c3RlbS1uYW1lCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHN5c3RlbQogICAgc3lzY2FsbHM6CiAgICAgICMgc2V0aG9zdG5hbWUgLSBnZXQvc2V0IGhvc3RuYW1lCiAgICAgIC0gc2V0aG9zdG5hbWUKICAgICAgIyBzZXRkb21haW5uYW1lIC0gZ2V0L3NldCBOSVMgZG9tYWluIG5hbWUKICAgICAgLSBzZXRkb21haW5uYW1lCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWhvc3QKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IGFsbG9jYXRlZC1tZW1vcnkKICAgIG9iamVjdDoKICAgICAgd2hhdDogbWVtb3J5CiAgICBzeXNjYWxsczoKICAgICAgIyBtbWFwIC0gbWFwIG9yIHVubWFwIGZpbGVzIG9yIGRldmljZXMgaW50byBtZW1vcnkKICAgICAgLSBtbWFwCiAgICAgICMgbW1hcDIgLSBtYXAgZmlsZXMgb3IgZGV2aWNlcyBpbnRvIG1lbW9yeQogICAgICAtIG1tYXAyCiAgICAgICMgYnJrIC0gY2hhbmdlIGRhdGEgc2VnbWVudCBzaXplCiAgICAgIC0gYnJrCiAgICBlY3M6ICplY3MtcHJvY2VzcwogIC0gYWN0aW9uOiBhZGp1c3RlZC1zY2hlZHVsaW5nLXBvbGljeS1vZgogICAgb2JqZWN0OgogICAgICB3aGF0OiBwcm9jZXNzCiAgICBob3c6IHN5c2NhbGwKICAgIHN5c2NhbGxzOgogICAgICAjIHNjaGVkX3NldHBhcmFtIC0gc2V0IGFuZCBnZXQgc2NoZWR1bGluZyBwYXJhbWV0ZXJzCiAgICAgIC0gc2NoZWRfc2V0cGFyYW0KICAgICAgIyBzY2hlZF9zZXRzY2hlZHVsZXIgLSBzZXQgYW5kIGdldCBzY2hlZHVsaW5nIHBvbGljeS9wYXJhbWV0ZXJzCiAgICAgIC0gc2NoZWRfc2V0c2NoZWR1bGVyCiAgICAgICMgc2NoZWRfc2V0YXR0ciAtIHNldCBhbmQgZ2V0IHNjaGVkdWxpbmcgcG9saWN5IGFuZCBhdHRyaWJ1dGVzCiAgICAgIC0gc2NoZWRfc2V0YXR0cgogICAgZWNzOgogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IGNoYW5nZQoKICAjIEN1cnJlbnRseSB1bmhhbmRsZWQKICAjIHRoaXMgbGlzdCBjb21lcyBmcm9tIHBhcnNpbmcgbGludXggbWFuIHBhZ2VzIGF0IGh0dHBzOi8vZ2l0Lmtlcm5lbC5vcmcvcHViL3NjbS9kb2NzL21hbi1wYWdlcy9tYW4tcGFnZXMuZ2l0CgogICMgc2lnYWN0aW9uIC0gZXhhbWluZSBhbmQgY2hhbmdlIGEgc2lnbmFsIGFjdGlvbgogICMgcnRfc2lnYWN0aW9uIC0gZXhhbWluZSBhbmQgY2hhbmdlIGEgc2lnbmFsIGFjdGlvbgogICMgcHJlYWQgLSByZWFkIGZyb20gb3Igd3JpdGUgdG8gYSBmaWxlIGRlc2NyaXB0b3IgYXQgYSBnaXZlbiBvZmZzZXQKICAjIHB3cml0ZSAtIHJlYWQgZnJvbSBvciB3cml0ZSB0byBhIGZpbGUgZGVzY3JpcHRvciBhdCBhIGdpdmVuIG9mZnNldAogICMgczM5MF9ndWFyZGVkX3N0b3JhZ2UgLSBvcGVyYXRpb25zIHdpdGggei9BcmNoaXRlY3R1cmUgZ3VhcmRlZCBzdG9yYWdlIGZhY2lsaXR5CiAgIyBzY2hlZF9nZXRhdHRyIC0gc2V0IGFuZCBnZXQgc2NoZWR1bGluZyBwb2xpY3kgYW5kIGF0dHJpYnV0ZXMKICAjIGdldHJ1c2FnZSAtIGdldCByZXNvdXJjZSB1c2FnZQogICMgZmxvY2sgLSBhcHBseSBvciByZW1vdmUgYW4gYWR2aXNvcnkgbG9jayBvbiBhbiBvcGVuIGZpbGUKICAjIHBpZGZkX2dldGZkIC0gb2J0YWluIGEgZHVwbGljYXRlIG9mIGFub3RoZXIgcHJvY2VzcydzIGZpbGUgZGVzY3JpcHRvcgogICMgY2xvY2tfbmFub3NsZWVwIC0gaGlnaC1yZXNvbHV0aW9uIHNsZWVwIHdpdGggc3BlY2lmaWFibGUgY2xvY2sKICAjIGdldHBhZ2VzaXplIC0gZ2V0IG1lbW9yeSBwYWdlIHNpemUKICAjIHBpZGZkX29wZW4gLSBvYnRhaW4gYSBmaWxlIGRlc2NyaXB0b3IgdGhhdCByZWZlcnMgdG8gYSBwcm9jZXNzCiAgIyBzcGxpY2UgLSBzcGxpY2UgZGF0YSB0by9mcm9tIGEgcGlwZQogICMgZ2V0cmVzdWlkIC0gZ2V0IHJlYWwsIGVmZmVjdGl2ZSBhbmQgc2F2ZWQgdXNlci9ncm91cCBJRHMKICAjIGdldHJlc2dpZCAtIGdldCByZWFsLCBlZmZlY3RpdmUgYW5kIHNhdmVkIHVzZXIvZ3JvdXAgSURzCiAgIyByZW1hcF9maWxlX3BhZ2VzIC0gY3JlYXRlIGEgbm9ubGluZWFyIGZpbGUgbWFwcGluZwogICMgaW9fY2FuY2VsIC0gY2FuY2VsIGFuIG91dHN0YW5kaW5nIGFzeW5jaHJvbm91cyBJL08gb3BlcmF0aW9uCiAgIyBwcmN0bCAtIG9wZXJhdGlvbnMgb24gYSBwcm9jZXNzIG9yIHRocmVhZAogICMgY2xvY2tfZ2V0cmVzIC0gY2xvY2sgYW5kIHRpbWUgZnVuY3Rpb25zCiAgIyBjbG9ja19nZXR0aW1lIC0gY2xvY2sgYW5kIHRpbWUgZnVuY3Rpb25zCiAgIyBnZXRncm91cHMgLSBnZXQvc2V0IGxpc3Qgb2Ygc3VwcGxlbWVudGFyeSBncm91cCBJRHMKICAjIHNldGdyb3VwcyAtIGdldC9zZXQgbGlzdCBvZiBzdXBwbGVtZW50YXJ5IGdyb3VwIElEcwogICMgdm1zcGxpY2UgLSBzcGxpY2UgdXNlciBwYWdlcyB0by9mcm9tIGEgcGlwZQogICMgZXBvbGxfY3JlYXRlIC0gb3BlbiBhbiBlcG9sbCBmaWxlIGRlc2NyaXB0b3IKICAjIGVwb2xsX2NyZWF0ZTEgLSBvcGVuIGFuIGVwb2xsIGZpbGUgZGVzY3JpcHRvcgogICMgcG9zaXhfZmFkdmlzZSAtIHByZWRlY2xhcmUgYW4gYWNjZXNzIHBhdHRlcm4gZm9yIGZpbGUgZGF0YQogICMgc2lnd2FpdGluZm8gLSBzeW5jaHJvbm91c2x5IHdhaXQgZm9yIHF1ZXVlZCBzaWduYWxzCiAgIyBzaWd0aW1lZHdhaXQgLSBzeW5jaHJvbm91c2x5IHdhaXQgZm9yIHF1ZXVlZCBzaWduYWxzCiAgIyBydF9zaWd0aW1lZHdhaXQgLSBzeW5jaHJvbm91c2x5IHdhaXQgZm9yIHF1ZXVlZCBzaWduYWxzCiAgIyBzaWdyZXR1cm4gLSByZXR1cm4gZnJvbSBzaWduYWwgaGFuZGxlciBhbmQgY2xlYW51cCBzdGFjayBmcmFtZQogICMgcnRfc2lncmV0dXJuIC0gcmV0dXJuIGZyb20gc2lnbmFsIGhhbmRsZXIgYW5kIGNsZWFudXAgc3RhY2sgZnJhbWUKICAjIGdldHJhbmRvbSAtIG9idGFpbiBhIHNlcmllcyBvZiByYW5kb20gYnl0ZXMKICAjIGtleWN0bCAtIG1hbmlwdWxhdGUgdGhlIGtlcm5lbCdzIGtleSBtYW5hZ2VtZW50IGZhY2lsaXR5CiAgIyBzY2hlZF9nZXRzY2hlZHVsZXIgLSBzZXQgYW5kIGdldCBzY2hlZHVsaW5nIHBvbGljeS9wYXJhbWV0ZXJzCiAgIyBtYmluZCAtIHNldCBtZW1vcnkgcG9saWN5IGZvciBhIG1lbW9yeSByYW5nZQogICMgZ2V0cHJpb3JpdHkgLSBnZXQvc2V0IHByb2dyYW0gc2NoZWR1bGluZyBwcmlvcml0eQogICMgc2V0cHJpb3JpdHkgLSBnZXQvc2V0IHByb2dyYW0gc2NoZWR1bGluZyBwcmlvcml0eQogICMgaW5vdGlmeV9pbml0IC0gaW5pdGlhbGl6ZSBhbiBpbm90aWZ5IGluc3RhbmNlCiAgIyBpbm90aWZ5X2luaXQxIC0gaW5pdGlhbGl6ZSBhbiBpbm90aWZ5IGluc3RhbmNlCiAgIyBzZW1jdGwgLSBTeXN0ZW0gViBzZW1hcGhvcmUgY29udHJvbCBvcGVyYXRpb25zCiAgIyBnZXRob3N0bmFtZSAtIGdldC9zZXQgaG9zdG5hbWUKICAjIHB0cmFjZSAtIHByb2Nlc3MgdHJhY2UKICAjIHN5c2N0bCAtIHJlYWQvd3JpdGUgc3lzdGVtIHBhcmFtZXRlcnMKICAjIGdldHBlZXJuYW1lIC0gZ2V0IG5hbWUgb2YgY29ubmVjdGVkIHBlZXIgc29ja2V0CiAgIyBmb3JrIC0gY3JlYXRlIGEgY2hpbGQgcHJvY2VzcwogICMgcXVlcnlfbW9kdWxlIC0gcXVlcnkgdGhlIGtlcm5lbCBmb3IgdmFyaW91cyBiaXRzIHBlcnRhaW5pbmcgdG8gbW9kdWxlcwogICMgaW9jdGxfbGlzdCAtIGxpc3Qgb2YgaW9jdGwgY2FsbHMgaW4gTGludXgvaTM4NiBrZXJuZWwKICAjIG1zZ2dldCAtIGdldCBhIFN5c3RlbSBWIG1lc3NhZ2UgcXVldWUgaWRlbnRpZmllcgogICMgc3B1X2NyZWF0ZSAtIGNyZWF0ZSBhIG5ldyBzcHUgY29udGV4dAogICMgc3VicGFnZV9wcm90IC0gZGVmaW5lIGEgc3VicGFnZSBwcm90ZWN0aW9uIGZvciBhbiBhZGRyZXNzIHJhbmdlCiAgIyBxdW90YWN0bCAtIG1hbmlwdWxhdGUgZGlzayBxdW90YXMKICAjIG5mc3NlcnZjdGwgLSBzeXNjYWxsIGludGVyZmFjZSB0byBrZXJuZWwgbmZzIGRhZW1vbgogICMgb3BlbmF0MiAtIG9wZW4gYW5kIHBvc3NpYmx5IGNyZWF0ZSBhIGZpbGUgKGV4dGVuZGVkKQogICMgZ2V0cmxpbWl0IC0gZ2V0L3NldCByZXNvdXJjZSBsaW1pdHMKICAjIHNldHJsaW1pdCAtIGdldC9zZXQgcmVzb3VyY2UgbGltaXRzCiAgIyBwcmxpbWl0IC0gZ2V0L3NldCByZXNvdXJjZSBsaW1pdHMKICAjIHRlZSAtIGR1cGxpY2F0aW5nIHBpcGUgY29udGVudAogICMgc2htZ2V0IC0gYWxsb2NhdGVzIGEgU3lzdGVtIFYgc2hhcmVkIG1lbW9yeSBzZWdtZW50CiAgIyBjcmVhdGVfbW9kdWxlIC0gY3JlYXRlIGEgbG9hZGFibGUgbW9kdWxlIGVudHJ5CiAgIyBnZXR0aW1lb2ZkYXkgLSBnZXQgLyBzZXQgdGltZQogICMgdGltZXJfY3JlYXRlIC0gY3JlYXRlIGEgUE9TSVggcGVyLXByb2Nlc3MgdGltZXIKICAjIGZhbm90aWZ5X21hcmsgLSBhZGQsIHJlbW92ZSwgb3IgbW9kaWZ5IGFuIGZhbm90aWZ5IG1hcmsgb24gYSBmaWxlc3lzdGVtIG9iamVjdAogICMgcGlwZSAtIGNyZWF0ZSBwaXBlCiAgIyBwaXBlMiAtIGNyZWF0ZSBwaXBlCiAgIyBpbnRybyAtIGludHJvZHVjdGlvbiB0byBzeXN0ZW0gY2FsbHMKICAjIGxvb2t1cF9kY29va2llIC0gcmV0dXJuIGEgZGlyZWN0b3J5IGVudHJ5J3MgcGF0aAogICMgc3lzZnMgLSBnZXQgZmlsZXN5c3RlbSB0eXBlIGluZm9ybWF0aW9uCiAgIyBzMzkwX3J1bnRpbWVfaW5zdHIgLSBlbmFibGUvZGlzYWJsZSBzMzkwIENQVSBydW4tdGltZSBpbnN0cnVtZW50YXRpb24KICAjIHNldG5zIC0gcmVhc3NvY2lhdGUgdGhyZWFkIHdpdGggYSBuYW1lc3BhY2UKICAjIHNldF9tZW1wb2xpY3kgLSBzZXQgZGVmYXVsdCBOVU1BIG1lbW9yeSBwb2xpY3kgZm9yIGEgdGhyZWFkIGFuZCBpdHMgY2hpbGRyZW4KICAjIG5pY2UgLSBjaGFuZ2UgcHJvY2VzcyBwcmlvcml0eQogICMgaW9fc2V0dXAgLSBjcmVhdGUgYW4gYXN5bmNocm9ub3VzIEkvTyBjb250ZXh0CiAgIyBtaW5jb3JlIC0gZGV0ZXJtaW5lIHdoZXRoZXIgcGFnZXMgYXJlIHJlc2lkZW50IGluIG1lbW9yeQogICMgaW9jdGxfY29uc29sZSAtIGlvY3RscyBmb3IgY29uc29sZSB0ZXJtaW5hbCBhbmQgdmlydHVhbCBjb25zb2xlcwogICMgcG9sbCAtIHdhaXQgZm9yIHNvbWUgZXZlbnQgb24gYSBmaWxlIGRlc2NyaXB0b3IKICAjIHBwb2xsIC0gd2FpdCBmb3Igc29tZSBldmVudCBvbiBhIGZpbGUgZGVzY3JpcHRvcgogICMgY2FwZ2V0IC0gc2V0L2dldCBjYXBhYmlsaXRpZXMgb2YgdGhyZWFkKHMpCiAgIyBjYXBzZXQgLSBzZXQvZ2V0IGNhcGFiaWxpdGllcyBvZiB0aHJlYWQocykKICAjIGlvY3RsX3R0eSAtIGlvY3RscyBmb3IgdGVybWluYWxzIGFuZCBzZXJpYWwgbGluZXMKICAjIGlvcGwgLSBjaGFuZ2UgSS9PIHByaXZpbGVnZSBsZXZlbAogICMgaW9fZ2V0ZXZlbnRzIC0gcmVhZCBhc3luY2hyb25vdXMgSS9PIGV2ZW50cyBmcm9tIHRoZSBjb21wbGV0aW9uIHF1ZXVlCiAgIyBwcm9jZXNzX3ZtX3JlYWR2IC0gdHJhbnNmZXIgZGF0YSBiZXR3ZWVuIHByb2Nlc3MgYWRkcmVzcyBzcGFjZXMKICAjIHByb2Nlc3Nfdm1fd3JpdGV2IC0gdHJhbnNmZXIgZGF0YSBiZXR3ZWVuIHByb2Nlc3MgYWRkcmVzcyBzcGFjZXMKICAjIHBjaWNvbmZpZ19yZWFkIC0gcGNpIGRldmljZSBpbmZvcm1hdGlvbiBoYW5kbGluZwogICMgcGNpY29uZmlnX3dyaXRlIC0gcGNpIGRldmljZSBpbmZvcm1hdGlvbiBoYW5kbGluZwogICMgcGNpY29uZmlnX2lvYmFzZSAtIHBjaSBkZXZpY2UgaW5mb3JtYXRpb24gaGFuZGxpbmcKICAjIHNicmsgLSBjaGFuZ2UgZGF0YSBzZWdtZW50IHNpemUKICAjIHNldF90aWRfYWRkcmVzcyAtIHNldCBwb2ludGVyIHRvIHRocmVhZCBJRAogICMgcGtleV9hbGxvYyAtIGFsbG9jYXRlIG9yIGZyZWUgYSBwcm90ZWN0aW9uIGtleQogICMgcGtleV9mcmVlIC0gYWxsb2NhdGUgb3IgZnJlZSBhIHByb3RlY3Rpb24ga2V5CiAgIyBzZWxlY3QgLSBzeW5jaHJvbm91cyBJL08gbXVsdGlwbGV4aW5nCiAgIyBwc2VsZWN0IC0gc3luY2hyb25vdXMgSS9PIG11bHRpcGxleGluZwogICMga2V4ZWNfbG9hZCAtIGxvYWQgYSBuZXcga2VybmVsIGZvciBsYXRlciBleGVjdXRpb24KICAjIGtleGVjX2ZpbGVfbG9hZCAtIGxvYWQgYSBuZXcga2VybmVsIGZvciBsYXRlciBleGVjdXRpb24KICAjIHBlcnNvbmFsaXR5IC0gc2V0IHRoZSBwcm9jZXNzIGV4ZWN1dGlvbiBkb21haW4KICAjIGlvY3RsX2dldGZzbWFwIC0gcmV0cmlldmUgdGhlIHBoeXNpY2FsIGxheW91dCBvZiB0aGUgZmlsZXN5c3RlbQogICMgaW5vdGlmeV9ybV93YXRjaCAtIHJlbW92ZSBhbiBleGlzdGluZyB3YXRjaCBmcm9tIGFuIGlub3RpZnkgaW5zdGFuY2UKICAjIHBlcmZfZXZlbnRfb3BlbiAtIHNldCB1cCBwZXJmb3JtYW5jZSBtb25pdG9yaW5nCiAgIyB0aW1lcl9nZXRvdmVycnVuIC0gZ2V0IG92ZXJydW4gY291bnQgZm9yIGEgUE9TSVggcGVyLXByb2Nlc3MgdGltZXIKICAjIHdhaXQzIC0gd2FpdCBmb3IgcHJvY2VzcyB0byBjaGFuZ2Ugc3RhdGUsIEJTRCBzdHlsZQogICMgd2FpdDQgLSB3YWl0IGZvciBwcm9jZXNzIHRvIGNoYW5nZSBzdGF0ZSwgQlNEIHN0eWxlCiAgIyBsaW5rIC0gbWFrZSBhIG5ldyBuYW1lIGZvciBhIGZpbGUKICAjIGxpbmthdCAtIG1ha2UgYSBuZXcgbmFtZSBmb3IgYSBmaWxlCiAgIyBzY2hlZF9ycl9nZXRfaW50ZXJ2YWwgLSBnZXQgdGhlIFNDSEVEX1JSIGludGVydmFsIGZvciB0aGUgbmFtZWQgcHJvY2VzcwogICMgbXVubWFwIC0gbWFwIG9yIHVubWFwIGZpbGVzIG9yIGRldmljZXMgaW50byBtZW1vcnkKICAjIHNvY2tldGNhbGwgLSBzb2NrZXQgc3lzdGVtIGNhbGxzCiAgIyBtcmVtYXAgLSByZW1hcCBhIHZpcnR1YWwgbWVtb3J5IGFkZHJlc3MKICAjIHZmb3JrIC0gY3JlYXRlIGEgY2hpbGQgcHJvY2VzcyBhbmQgYmxvY2sgcGFyZW50CiAgIyBzZWNjb21wIC0gb3BlcmF0ZSBvbiBTZWN1cmUgQ29tcHV0aW5nIHN0YXRlIG9mIHRoZSBwcm9jZXNzCiAgIyByZXF1ZXN0X2tleSAtIHJlcXVlc3QgYSBrZXkgZnJvbSB0aGUga2VybmVsJ3Mga2V5IG1hbmFnZW1lbnQgZmFjaWxpdHkKICAjIHN5c2NhbGwgLSBpbmRpcmVjdCBzeXN0ZW0gY2FsbAogICMga2NtcCAtIGNvbXBhcmUgdHdvIHByb2Nlc3NlcyB0byBkZXRlcm1pbmUgaWYgdGhleSBzaGFyZSBhIGtlcm5lbCByZXNvdXJjZQogICMgcmVhZGRpciAtIHJlYWQgZGlyZWN0b3J5IGVudHJ5CiAgIyBpb3Blcm0gLSBzZXQgcG9ydCBpbnB1dC9vdXRwdXQgcGVybWlzc2lvbnMKICAjIHNwdV9ydW4gLSBleGVjdXRlIGFuIFNQVSBjb250ZXh0CiAgIyByZWJvb3QgLSByZWJvb3Qgb3IgZW5hYmxlL2Rpc2FibGUgQ3RybC1BbHQtRGVsCiAgIyByZWFkYWhlYWQgLSBpbml0aWF0ZSBmaWxlIHJlYWRhaGVhZCBpbnRvIHBhZ2UgY2FjaGUKICAjIHNjaGVkX2dldHBhcmFtIC0gc2V0IGFuZCBnZXQgc2NoZWR1bGluZyBwYXJhbWV0ZXJzCiAgIyBhY2N0IC0gc3dpdGNoIHByb2Nlc3MgYWNjb3VudGluZyBvbiBvciBvZmYKICAjIHNpZ3N1c3BlbmQgLSB3YWl0IGZvciBhIHNpZ25hbAogICMgcnRfc2lnc3VzcGVuZCAtIHdhaXQgZm9yIGEgc2lnbmFsCiAgIyBleGl0X2dyb3VwIC0gZXhpdCBhbGwgdGhyZWFkcyBpbiBhIHByb2Nlc3MKICAjIHNvY2tldCAtIGNyZWF0ZSBhbiBlbmRwb2ludCBmb3IgY29tbXVuaWNhdGlvbgogICMgaW9jdGxfdXNlcmZhdWx0ZmQgLSBjcmVhdGUgYSBmaWxlIGRlc2NyaXB0b3IgZm9yIGhhbmRsaW5nIHBhZ2UgZmF1bHRzIGluIHVzZXIgc3BhY2UKICAjIHNjaGVkX2dldF9wcmlvcml0eV9tYXggLSBnZXQgc3RhdGljIHByaW9yaXR5IHJhbmdlCiAgIyBzY2hlZF9nZXRfcHJpb3JpdHlfbWluICAtIGdldCBzdGF0aWMgcHJpb3JpdHkgcmFuZ2UKICAjIGdldGRlbnRzIC0gZ2V0IGRpcmVjdG9yeSBlbnRyaWVzCiAgIyBnZXRkZW50czY0IC0gZ2V0IGRpcmVjdG9yeSBlbnRyaWVzCiAgIyBzZWxlY3QgLSBzeW5jaHJvbm91cyBJL08gbXVsdGlwbGV4aW5nCiAgIyBwc2VsZWN0IC0gc3luY2hyb25vdXMgSS9PIG11bHRpcGxleGluZwogICMgYWxsb2NfaHVnZXBhZ2VzIC0gYWxsb2NhdGUgb3IgZnJlZSBodWdlIHBhZ2VzCiAgIyBmcmVlX2h1Z2VwYWdlcyAtIGFsbG9jYXRlIG9yIGZyZWUgaHVnZSBwYWdlcwogICMgZnN5bmMgLSBzeW5jaHJvbml6ZSBhIGZpbGUncyBpbi1jb3JlIHN0YXRlIHdpdGggc3RvcmFnZSBkZXZpY2UKICAjIGZkYXRhc3luYyAtIHN5bmNocm9uaXplIGEgZmlsZSdzIGluLWNvcmUgc3RhdGUgd2l0aCBzdG9yYWdlIGRldmljZQogICMgc3lzY2FsbHMgLSBMaW51eCBzeXN0ZW0gY2FsbHMKICAjIG1lbWZkX2NyZWF0ZSAtIGNyZWF0ZSBhbiBhbm9ueW1vdXMgZmlsZQogICMgbW9kaWZ5X2xkdCAtIGdldCBvciBzZXQgYSBwZXItcHJvY2VzcyBMRFQgZW50cnkKICAjIGdldF9rZXJuZWxfc3ltcyAtIHJldHJpZXZlIGV4cG9ydGVkIGtlcm5lbCBhbmQgbW9kdWxlIHN5bWJvbHMKICAjIGxzZWVrIC0gcmVwb3NpdGlvbiByZWFkL3dyaXRlIGZpbGUgb2Zmc2V0CiAgIyBzaG1hdCAtIFN5c3RlbSBWIHNoYXJlZCBtZW1vcnkgb3BlcmF0aW9ucwogICMgc2htZHQgLSBTeXN0ZW0gViBzaGFyZWQgbWVtb3J5IG9wZXJhdGlvbnMKICAjIHRpbWVyX2RlbGV0ZSAtIGRlbGV0ZSBhIFBPU0lYIHBlci1wcm9jZXNzIHRpbWVyCiAgIyBwZXJmbW9uY3RsIC0gaW50ZXJmYWNlIHRvIElBLTY0IHBlcmZvcm1hbmNlIG1vbml0b3JpbmcgdW5pdAogICMgbW92ZV9wYWdlcyAtIG1vdmUgaW5kaXZpZHVhbCBwYWdlcyBvZiBhIHByb2Nlc3MgdG8gYW5vdGhlciBub2RlCiAgIyBjaGRpciAtIGNoYW5nZSB3b3JraW5nIGRpcmVjdG9yeQogICMgZmNoZGlyIC0gY2hhbmdlIHdvcmtpbmcgZGlyZWN0b3J5CiAgIyB0aW1lIC0gZ2V0IHRpbWUgaW4gc2Vjb25kcwogICMgX2V4aXQgLSB0ZXJtaW5hdGUgdGhlIGNhbGxpbmcgcHJvY2VzcwogICMgX0V4aXQgLSB0ZXJtaW5hdGUgdGhlIGNhbGxpbmcgcHJvY2VzcwogICMgczM5MF9zdGh5aSAtIGVtdWxhdGUgU1RIWUkgaW5zdHJ1Y3Rpb24KICAjIGlvX3N1Ym1pdCAtIHN1Ym1pdCBhc3luY2hyb25vdXMgSS9PIGJsb2NrcyBmb3IgcHJvY2Vzc2luZwogICMgbWxvY2sgLSBsb2NrIGFuZCB1bmxvY2sgbWVtb3J5CiAgIyBtbG9jazIgLSBsb2NrIGFuZCB1bmxvY2sgbWVtb3J5CiAgIyBtdW5sb2NrIC0gbG9jayBhbmQgdW5sb2NrIG1lbW9yeQogICMgbWxvY2thbGwgLSBsb2NrIGFuZCB1bmxvY2sgbWVtb3J5CiAgIyBtdW5sb2NrYWxsIC0gbG9jayBhbmQgdW5sb2NrIG1lbW9yeQogICMgdW1hc2sgLSBzZXQgZmlsZSBtb2RlIGNyZWF0aW9uIG1hc2sKICAjIGFyY2hfcHJjdGwgLSBzZXQgYXJjaGl0ZWN0dXJlLXNwZWNpZmljIHRocmVhZCBzdGF0ZQogICMgdXNlbGliIC0gbG9hZCBzaGFyZWQgbGlicmFyeQogICMgc2VuZGZpbGUgLSB0cmFuc2ZlciBkYXRhIGJldHdlZW4gZmlsZSBkZXNjcmlwdG9ycwogICMgc2htY3RsIC0gU3lzdGVtIFYgc2hhcmVkIG1lbW9yeSBjb250cm9sCiAgIyBlcG9sbF93YWl0IC0gd2FpdCBmb3IgYW4gSS9PIGV2ZW50IG9uIGFuIGVwb2xsIGZpbGUgZGVzY3JpcHRvcgogICMgZXBvbGxfcHdhaXQgLSB3YWl0IGZvciBhbiBJL08gZXZlbnQgb24gYW4gZXBvbGwgZmlsZSBkZXNjcmlwdG9yCiAgIyBzaWdhbHRzdGFjayAtIHNldCBhbmQvb3IgZ2V0IHNpZ25hbCBzdGFjayBjb250ZXh0CiAgIyBpb2N0bCAtIGNvbnRyb2wgZGV2aWNlCiAgIyBzaWduYWxmZCAtIGNyZWF0ZSBhIGZpbGUgZGVzY3JpcHRvciBmb3IgYWNjZXB0aW5nIHNpZ25hbHMKICAjIHVuc2hhcmUgLSBkaXNhc3NvY2lhdGUgcGFydHMgb2YgdGhlIHByb2Nlc3MgZXhlY3V0aW9uIGNvbnRleHQKICAjIGNocm9vdCAtIGNoYW5nZSByb290IGRpcmVjdG9yeQogICMgbWFkdmlzZSAtIGdpdmUgYWR2aWNlIGFib3V0IHVzZSBvZiBtZW1vcnkKICAjIGdldHRpZCAtIGdldCB0aHJlYWQgaWRlbnRpZmljYXRpb24KICAjIGdldHNvY2tuYW1lIC0gZ2V0IHNvY2tldCBuYW1lCiAgIyBpb19kZXN0cm95IC0gZGVzdHJveSBhbiBhc3luY2hyb25vdXMgSS9PIGNvbnRleHQKICAjIHNldHVwIC0gc2V0dXAgZGV2aWNlcyBhbmQgZmlsZXN5c3RlbXMsIG1vdW50IHJvb3QgZmlsZXN5c3RlbQogICMgbnRwX2FkanRpbWUgLSB0dW5lIGtlcm5lbCBjbG9jawogICMgc2VtZ2V0IC0gZ2V0IGEgU3lzdGVtIFYgc2VtYXBob3JlIHNldCBpZGVudGlmaWVyCiAgIyBnZXR1aWQgLSBnZXQgdXNlciBpZGVudGl0eQogICMgZ2V0ZXVpZCAtIGdldCB1c2VyIGlkZW50aXR5CiAgIyBfc3lzY2FsbCAtIGludm9raW5nIGEgc3lzdGVtIGNhbGwgd2l0aG91dCBsaWJyYXJ5IHN1cHBvcnQgKE9CU09MRVRFKQogICMgc2NoZWRfc2V0YWZmaW5pdHkgLSBcIHNldCBhbmQgZ2V0IGEgdGhyZWFkJ3MgQ1BVIGFmZmluaXR5IG1hc2sKICAjIHNjaGVkX2dldGFmZmluaXR5IC0gXCBzZXQgYW5kIGdldCBhIHRocmVhZCdzIENQVSBhZmZpbml0eSBtYXNrCiAgIyBjYWNoZWZsdXNoIC0gZmx1c2ggY29udGVudHMgb2YgaW5zdHJ1Y3Rpb24gYW5kL29yIGRhdGEgY2FjaGUKICAjIHBpdm90X3Jvb3QgLSBjaGFuZ2UgdGhlIHJvb3QgbW91bnQKICAjIG1zZ2N0bCAtIFN5c3RlbSBWIG1lc3NhZ2UgY29udHJvbCBvcGVyYXRpb25zCiAgIyBpb2N0bF9mYXQgLSBtYW5pcHVsYXRpbmcgdGhlIEZBVCBmaWxlc3lzdGVtCiAgIyBzZXRwZ2lkIC0gc2V0L2dldCBwcm9jZXNzIGdyb3VwCiAgIyBnZXRwZ2lkIC0gc2V0L2dldCBwcm9jZXNzIGdyb3VwCiAgIyBzZXRwZ3JwIC0gc2V0L2dldCBwcm9jZXNzIGdyb3VwCiAgIyBnZXRwZ3JwIC0gc2V0L2dldCBwcm9jZXNzIGdyb3VwCiAgIyBzb2NrZXRwYWlyIC0gY3JlYXRlIGEgcGFpciBvZiBjb25uZWN0ZWQgc29ja2V0cwogICMgYmRmbHVzaCAtIHN0YXJ0LCBmbHVzaCwgb3IgdHVuZSBidWZmZXItZGlydHktZmx1c2ggZGFlbW9uCiAgIyBhbGFybSAtIHNldCBhbiBhbGFybSBjbG9jayBmb3IgZGVsaXZlcnkgb2YgYSBzaWduYWwKICAjIHRpbWVyX3NldHRpbWUgLSBhcm0vZGlzYXJtIGFuZCBmZXRjaCBzdGF0ZSBvZiBQT1NJWCBwZXItcHJvY2VzcyB0aW1lcgogICMgdGltZXJfZ2V0dGltZSAtIGFybS9kaXNhcm0gYW5kIGZldGNoIHN0YXRlIG9mIFBPU0lYIHBlci1wcm9jZXNzIHRpbWVyCiAgIyBhZGRfa2V5IC0gYWRkIGEga2V5IHRvIHRoZSBrZXJuZWwncyBrZXkgbWFuYWdlbWVudCBmYWNpbGl0eQogICMgcnRfc2lncXVldWVpbmZvIC0gcXVldWUgYSBzaWduYWwgYW5kIGRhdGEKICAjIHJ0X3Rnc2lncXVldWVpbmZvIC0gcXVldWUgYSBzaWduYWwgYW5kIGRhdGEKICAjIHVzZXJmYXVsdGZkIC0gY3JlYXRlIGEgZmlsZSBkZXNjcmlwdG9yIGZvciBoYW5kbGluZyBwYWdlIGZhdWx0cyBpbiB1c2VyIHNwYWNlCiAgIyBzZW1vcCAtIFN5c3RlbSBWIHNlbWFwaG9yZSBvcGVyYXRpb25zCiAgIyBzZW10aW1lZG9wIC0gU3lzdGVtIFYgc2VtYXBob3JlIG9wZXJhdGlvbnMKICAjIGdldGdpZCAtIGdldCBncm91cCBpZGVudGl0eQogICMgZ2V0ZWdpZCAtIGdldCBncm91cCBpZGVudGl0eQogICMgZ2V0cGlkIC0gZ2V0IHByb2Nlc3MgaWRlbnRpZmljYXRpb24KICAjIGdldHBwaWQgLSBnZXQgcHJvY2VzcyBpZGVudGlmaWNhdGlvbgogICMgc2lncHJvY21hc2sgLSBleGFtaW5lIGFuZCBjaGFuZ2UgYmxvY2tlZCBzaWduYWxzCiAgIyBydF9zaWdwcm9jbWFzayAtIGV4YW1pbmUgYW5kIGNoYW5nZSBibG9ja2VkIHNpZ25hbHMKICAjIHVuYW1lIC0gZ2V0IG5hbWUgYW5kIGluZm9ybWF0aW9uIGFib3V0IGN1cnJlbnQga2VybmVsCiAgIyBzdGF0eCAtIGdldCBmaWxlIHN0YXR1cyAoZXh0ZW5kZWQpCiAgIyBpb2N0bF9maWNsb25lcmFuZ2UgLSBzaGFyZSBzb21lIHRoZSBkYXRhIG9mIG9uZSBmaWxlIHdpdGggYW5vdGhlciBmaWxlCiAgIyBpb2N0bF9maWNsb25lIC0gc2hhcmUgc29tZSB0aGUgZGF0YSBvZiBvbmUgZmlsZSB3aXRoIGFub3RoZXIgZmlsZQogICMgbXFfZ2V0c2V0YXR0ciAtIGdldC9zZXQgbWVzc2FnZSBxdWV1ZSBhdHRyaWJ1dGVzCiAgIyBpb3ByaW9fZ2V0IC0gZ2V0L3NldCBJL08gc2NoZWR1bGluZyBjbGFzcyBhbmQgcHJpb3JpdHkKICAjIGlvcHJpb19zZXQgLSBnZXQvc2V0IEkvTyBzY2hlZHVsaW5nIGNsYXNzIGFuZCBwcmlvcml0eQogICMgZXBvbGxfY3RsIC0gY29udHJvbCBpbnRlcmZhY2UgZm9yIGFuIGVwb2xsIGZpbGUgZGVzY3JpcHRvcgogICMgc3luYyAtIGNvbW1pdCBmaWxlc3lzdGVtIGNhY2hlcyB0byBkaXNrCiAgIyBzeW5jZnMgLSBjb21taXQgZmlsZXN5c3RlbSBjYWNoZXMgdG8gZGlzawogICMgc2V0c2lkIC0gY3JlYXRlcyBhIHNlc3Npb24gYW5kIHNldHMgdGhlIHByb2Nlc3MgZ3JvdXAgSUQKICAjIHNodXRkb3duIC0gc2h1dCBkb3duIHBhcnQgb2YgYSBmdWxsLWR1cGxleCBjb25uZWN0aW9uCiAgIyBnZXRzaWQgLSBnZXQgc2Vzc2lvbiBJRAogICMgZ2V0X3RocmVhZF9hcmVhIC0gbWFuaXB1bGF0ZSB0aHJlYWQtbG9jYWwgc3RvcmFnZSBpbmZvcm1hdGlvbgogICMgc2V0X3RocmVhZF9hcmVhIC0gbWFuaXB1bGF0ZSB0aHJlYWQtbG9jYWwgc3RvcmFnZSBpbmZvcm1hdGlvbgogICMgdGltZXJmZF9jcmVhdGUgLSB0aW1lcnMgdGhhdCBub3RpZnkgdmlhIGZpbGUgZGVzY3JpcHRvcnMKICAjIHRpbWVyZmRfc2V0dGltZSAtIHRpbWVycyB0aGF0IG5vdGlmeSB2aWEgZmlsZSBkZXNjcmlwdG9ycwogICMgdGltZXJmZF9nZXR0aW1lIC0gdGltZXJzIHRoYXQgbm90aWZ5IHZpYSBmaWxlIGRlc2NyaXB0b3JzCiAgIyBpb2N0bF9maWRlZHVwZXJhbmdlIC0gc2hhcmUgc29tZSB0aGUgZGF0YSBvZiBvbmUgZmlsZSB3aXRoIGFub3RoZXIgZmlsZQogICMgbmFtZV90b19oYW5kbGVfYXQgLSBvYnRhaW4gaGFuZGxlIGZvciBhIHBhdGhuYW1lIGFuZCBvcGVuIGZpbGUgdmlhIGEgaGFuZGxlCiAgIyBvcGVuX2J5X2hhbmRsZV9hdCAtIG9idGFpbiBoYW5kbGUgZm9yIGEgcGF0aG5hbWUgYW5kIG9wZW4gZmlsZSB2aWEgYSBoYW5kbGUKICAjIGZ1dGV4IC0gZmFzdCB1c2VyLXNwYWNlIGxvY2tpbmcKICAjIGlvY3RsX25zIC0gaW9jdGwoKSBvcGVyYXRpb25zIGZvciBMaW51eCBuYW1lc3BhY2VzCiAgIyBwaWRmZF9zZW5kX3NpZ25hbCAtIHNlbmQgYSBzaWduYWwgdG8gYSBwcm9jZXNzIHNwZWNpZmllZCBieSBhIGZpbGUgZGVzY3JpcHRvcgogICMgY2xvbmUgLSBjcmVhdGUgYSBjaGlsZCBwcm9jZXNzCiAgIyBfX2Nsb25lMiAtIGNyZWF0ZSBhIGNoaWxkIHByb2Nlc3MKICAjIGNsb25lMyAtIGNyZWF0ZSBhIGNoaWxkIHByb2Nlc3MKICAjIHRpbWVzIC0gZ2V0IHByb2Nlc3MgdGltZXMKICAjIHN5c2luZm8gLSByZXR1cm4gc3lzdGVtIGluZm9ybWF0aW9uCiAgIyBpcGMgLSBTeXN0ZW0gViBJUEMgc3lzdGVtIGNhbGxzCiAgIyBldmVudGZkIC0gY3JlYXRlIGEgZmlsZSBkZXNjcmlwdG9yIGZvciBldmVudCBub3RpZmljYXRpb24KICAjIHdhaXQgLSB3YWl0IGZvciBwcm9jZXNzIHRvIGNoYW5nZSBzdGF0ZQogICMgd2FpdHBpZCAtIHdhaXQgZm9yIHByb2Nlc3MgdG8gY2hhbmdlIHN0YXRlCiAgIyB3YWl0aWQgLSB3YWl0IGZvciBwcm9jZXNzIHRvIGNoYW5nZSBzdGF0ZQogICMgZ2V0ZG9tYWlubmFtZSAtIGdldC9zZXQgTklTIGRvbWFpbiBuYW1lCiAgIyBpZGxlIC0gbWFrZSBwcm9jZXNzIDAgaWRsZQogICMgaW5vdGlmeV9hZGRfd2F0Y2ggLSBhZGQgYSB3YXRjaCB0byBhbiBpbml0aWFsaXplZCBpbm90aWZ5IGluc3RhbmNlCiAgIyBnZXRfbWVtcG9saWN5IC0gcmV0cmlldmUgTlVNQSBtZW1vcnkgcG9saWN5IGZvciBhIHRocmVhZAogICMgYnBmIC0gcGVyZm9ybSBhIGNvbW1hbmQgb24gYW4gZXh0ZW5kZWQgQlBGIG1hcCBvciBwcm9ncmFtCiAgIyBnZXRzb2Nrb3B0IC0gZ2V0IGFuZCBzZXQgb3B0aW9ucyBvbiBzb2NrZXRzCiAgIyBzZXRzb2Nrb3B0IC0gZ2V0IGFuZCBzZXQgb3B0aW9ucyBvbiBzb2NrZXRzCiAgIyBnZXRpdGltZXIgLSBnZXQgb3Igc2V0IHZhbHVlIG9mIGFuIGludGVydmFsIHRpbWVyCiAgIyBzZXRpdGltZXIgLSBnZXQgb3Igc2V0IHZhbHVlIG9mIGFuIGludGVydmFsIHRpbWVyCiAgIyBtZW1iYXJyaWVyIC0gaXNzdWUgbWVtb3J5IGJhcnJpZXJzIG9uIGEgc2V0IG9mIHRocmVhZHMKICAjIG1wcm90ZWN0IC0gc2V0IHByb3RlY3Rpb24gb24gYSByZWdpb24gb2YgbWVtb3J5CiAgIyBwa2V5X21wcm90ZWN0IC0gc2V0IHByb3RlY3Rpb24gb24gYSByZWdpb24gb2YgbWVtb3J5CiAgIyBnZXRjcHUgLSBkZXRlcm1pbmUgQ1BVIGFuZCBOVU1BIG5vZGUgb24gd2hpY2ggdGhlIGNhbGxpbmcgdGhyZWFkIGlzIHJ1bm5pbmcKICAjIGlvY3RsX2ZzbGFiZWwgLSBnZXQgb3Igc2V0IGEgZmlsZXN5c3RlbSBsYWJlbAogICMgbGlzdHhhdHRyIC0gbGlzdCBleHRlbmRlZCBhdHRyaWJ1dGUgbmFtZXMKICAjIGxsaXN0eGF0dHIgLSBsaXN0IGV4dGVuZGVkIGF0dHJpYnV0ZSBuYW1lcwogICMgZmxpc3R4YXR0ciAtIGxpc3QgZXh0ZW5kZWQgYXR0cmlidXRlIG5hbWVzCiAgIyBfbGxzZWVrIC0gcmVwb3NpdGlvbiByZWFkL3dyaXRlIGZpbGUgb2Zmc2V0CiAgIyBmY250bCAtIG1hbmlwdWxhdGUgZmlsZSBkZXNjcmlwdG9yCiAgIyB1c3RhdCAtIGdldCBmaWxlc3lzdGVtIHN0YXRpc3RpY3MKICAjIHNpZ25hbCAtIEFOU0kgQyBzaWduYWwgaGFuZGxpbmcKICAjIG5hbm9zbGVlcCAtIGhpZ2gtcmVzb2x1dGlvbiBzbGVlcAogICMgY29ubmVjdCAtIGluaXRpYXRlIGEgY29ubmVjdGlvbiBvbiBhIHNvY2tldAogICMgdm04Nm9sZCAtIGVudGVyIHZpcnR1YWwgODA4NiBtb2RlCiAgIyB2bTg2IC0gZW50ZXIgdmlydHVhbCA4MDg2IG1vZGUKICAjIGZhbm90aWZ5X2luaXQgLSBjcmVhdGUgYW5kIGluaXRpYWxpemUgZmFub3RpZnkgZ3JvdXAKICAjIG1pZ3JhdGVfcGFnZXMgLSBtb3ZlIGFsbCBwYWdlcyBpbiBhIHByb2Nlc3MgdG8gYW5vdGhlciBzZXQgb2Ygbm9kZXMKICAjIHJlc3RhcnRfc3lzY2FsbCAtIHJlc3RhcnQgYSBzeXN0ZW0gY2FsbCBhZnRlciBpbnRlcnJ1cHRpb24gYnkgYSBzdG9wIHNpZ25hbAogICMgY2xvc2UgLSBjbG9zZSBhIGZpbGUgZGVzY3JpcHRvcgogICMgbXNncmN2IC0gU3lzdGVtIFYgbWVzc2FnZSBxdWV1ZSBvcGVyYXRpb25zCiAgIyBtc2dzbmQgLSBTeXN0ZW0gViBtZXNzYWdlIHF1ZXVlIG9wZXJhdGlvbnMKICAjIHJlYWR2IC0gcmVhZCBvciB3cml0ZSBkYXRhIGludG8gbXVsdGlwbGUgYnVmZmVycwogICMgd3JpdGV2IC0gcmVhZCBvciB3cml0ZSBkYXRhIGludG8gbXVsdGlwbGUgYnVmZmVycwogICMgcHJlYWR2IC0gcmVhZCBvciB3cml0ZSBkYXRhIGludG8gbXVsdGlwbGUgYnVmZmVycwogICMgcHdyaXRldiAtIHJlYWQgb3Igd3JpdGUgZGF0YSBpbnRvIG11bHRpcGxlIGJ1ZmZlcnMKICAjIHByZWFkdjIgLSByZWFkIG9yIHdyaXRlIGRhdGEgaW50byBtdWx0aXBsZSBidWZmZXJzCiAgIyBwd3JpdGV2MiAtIHJlYWQgb3Igd3JpdGUgZGF0YSBpbnRvIG11bHRpcGxlIGJ1ZmZlcnMKICAjIHN5c2xvZyAtIHJlYWQgYW5kL29yIGNsZWFyIGtlcm5lbCBtZXNzYWdlIHJpbmcgYnVmZmVyOyBzZXQgY29uc29sZV9sb2dsZXZlbAogICMga2xvZ2N0bCAtIHJlYWQgYW5kL29yIGNsZWFyIGtlcm5lbCBtZXNzYWdlIHJpbmcgYnVmZmVyOyBzZXQgY29uc29sZV9sb2dsZXZlbAogICMgc2NoZWRfeWllbGQgLSB5aWVsZCB0aGUgcHJvY2Vzc29yCiAgIyB2aGFuZ3VwIC0gdmlydHVhbGx5IGhhbmd1cCB0aGUgY3VycmVudCB0ZXJtaW5hbAogICMgaW9jdGxfaWZsYWdzIC0gaW9jdGwoKSBvcGVyYXRpb25zIGZvciBpbm9kZSBmbGFncwogICMgc2dldG1hc2sgLSBtYW5pcHVsYXRpb24gb2Ygc2lnbmFsIG1hc2sgKG9ic29sZXRlKQogICMgc3NldG1hc2sgLSBtYW5pcHVsYXRpb24gb2Ygc2lnbmFsIG1hc2sgKG9ic29sZXRlKQogICMgc3luY19maWxlX3JhbmdlIC0gc3luYyBhIGZpbGUgc2VnbWVudCB3aXRoIGRpc2sKICAjIGNvcHlfZmlsZV9yYW5nZSAtIENvcHkgYSByYW5nZSBvZiBkYXRhIGZyb20gb25lIGZpbGUgdG8gYW5vdGhlcgogICMgc2lncGVuZGluZyAtIGV4YW1pbmUgcGVuZGluZyBzaWduYWxzCiAgIyBydF9zaWdwZW5kaW5nIC0gZXhhbWluZSBwZW5kaW5nIHNpZ25hbHMKICAjIGdldHVud2luZCAtIGNvcHkgdGhlIHVud2luZCBkYXRhIHRvIGNhbGxlcidzIGJ1ZmZlcgogICMgbXN5bmMgLSBzeW5jaHJvbml6ZSBhIGZpbGUgd2l0aCBhIG1lbW9yeSBtYXAKICAjIGdldF9yb2J1c3RfbGlzdCAtIGdldC9zZXQgbGlzdCBvZiByb2J1c3QgZnV0ZXhlcwogICMgc2V0X3JvYnVzdF9saXN0IC0gZ2V0L3NldCBsaXN0IG9mIHJvYnVzdCBmdXRleGVzCiAgIyBkdXAgLSBkdXBsaWNhdGUgYSBmaWxlIGRlc2NyaXB0b3IKICAjIGR1cDIgLSBkdXBsaWNhdGUgYSBmaWxlIGRlc2NyaXB0b3IKICAjIGR1cDMgLSBkdXBsaWNhdGUgYSBmaWxlIGRlc2NyaXB0b3IKICAjIHMzOTBfcGNpX21taW9fd3JpdGUgLSB0cmFuc2ZlciBkYXRhIHRvL2Zyb20gUENJIE1NSU8gbWVtb3J5IHBhZ2UKICAjIHMzOTBfcGNpX21taW9fcmVhZCAtIHRyYW5zZmVyIGRhdGEgdG8vZnJvbSBQQ0kgTU1JTyBtZW1vcnkgcGFnZQogICMgcGF1c2UgLSB3YWl0IGZvciBzaWduYWwKICAjIHN3YXBvbiAtIHN0YXJ0L3N0b3Agc3dhcHBpbmcgdG8gZmlsZS9kZXZpY2UKICAjIHN3YXBvZmYgLSBzdGFydC9zdG9wIHN3YXBwaW5nIHRvIGZpbGUvZGV2aWNlCgogICMgUmVjb3JkIHR5cGUgbm9ybWFsaXphdGlvbnMKICAjIFVzZWZ1bCBsaW5rczoKICAjIGh0dHBzOi8vcmF3LmdpdGh1YnVzZXJjb250ZW50LmNvbS90b3J2YWxkcy9saW51eC92NC4xNi9pbmNsdWRlL3VhcGkvbGludXgvYXVkaXQuaAogICMgaHR0cHM6Ly9yYXcuZ2l0aHVidXNlcmNvbnRlbnQuY29tL2xpbnV4LWF1ZGl0L2F1ZGl0LXVzZXJzcGFjZS80ZDkzMzMwMWIxODM1Y2FmYTA4YjllOWVmNzA1YzhmYjZjOTZjYjYyL2xpYi9saWJhdWRpdC5oCiAgIyBodHRwczovL3d3dy5lbGFzdGljLmNvL2d1aWRlL2VuL2Vjcy9jdXJyZW50L2Vjcy1hbGxvd2VkLXZhbHVlcy1ldmVudC1jYXRlZ29yeS5odG1sCgogICMgSUFNIHJlbGF0ZWQgZXZlbnRzCgogICMgQVVESVRfQUNDVF9MT0NLIC0gVXNlcidzIGFjY291bnQgbG9ja2VkIGJ5IGFkbWluCiAgLSByZWNvcmRfdHlwZXM6IEFDQ1RfTE9DSwogICAgYWN0aW9uOiBsb2NrZWQtYWNjb3VudAogICAgZWNzOgogICAgICA8PDogKmVjcy1pYW0KICAgICAgdHlwZToKICAgICAgICAtIHVzZXIKICAgICAgICAtIGluZm8KICAjIEFVRElUX0FDQ1RfVU5MT0NLIC0gVXNlcidzIGFjY291bnQgdW5sb2NrZWQgYnkgYWRtaW4KICAtIHJlY29yZF90eXBlczogQUNDVF9VTkxPQ0sKICAgIGFjdGlvbjogdW5sb2NrZWQtYWNjb3VudAogICAgZWNzOgogICAgICA8PDogKmVjcy1pYW0KICAgICAgdHlwZToKICAgICAgICAtIHVzZXIKICAgICAgICAtIGluZm8KICAjIEFVRElUX0FERF9HUk9VUCAtIEdyb3VwIGFjY291bnQgYWRkZWQKICAtIHJlY29yZF90eXBlczogQUREX0dST1VQCiAgICBhY3Rpb246IGFkZGVkLWdyb3VwLWFjY291bnQtdG8KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogW2lkLCBhY2N0XQogICAgICB3aGF0OiBhY2NvdW50CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWlhbQogICAgICA8PDogKmVjcy1ncm91cC1tb2RpZmljYXRpb24tbWFwcGluZ3MKICAgICAgdHlwZToKICAgICAgICAtIGdyb3VwCiAgICAgICAgLSBjcmVhdGlvbgoKICAjIEFVRElUX0FERF9VU0VSIC0gVXNlciBhY2NvdW50IGFkZGVkCiAgLSByZWNvcmRfdHlwZXM6IEFERF9VU0VSCiAgICBhY3Rpb246IGFkZGVkLXVzZXItYWNjb3VudAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbaWQsIGFjY3RdCiAgICAgIHdoYXQ6IGFjY291bnQKICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIDw8OiAqZWNzLXVzZXItbW9kaWZpY2F0aW9uLW1hcHBpbmdzCiAgICAgIHR5cGU6CiAgICAgICAgLSB1c2VyCiAgICAgICAgLSBjcmVhdGlvbgogICMgQVVESVRfREVMX0dST1VQIC0gR3JvdXAgYWNjb3VudCBkZWxldGVkCiAgLSByZWNvcmRfdHlwZXM6IERFTF9HUk9VUAogICAgYWN0aW9uOiBkZWxldGVkLWdyb3VwLWFjY291bnQtZnJvbQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbaWQsIGFjY3RdCiAgICAgIHdoYXQ6IGFjY291bnQKICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIDw8OiAqZWNzLWdyb3VwLW1vZGlmaWNhdGlvbi1tYXBwaW5ncwogICAgICB0eXBlOgogICAgICAgIC0gZ3JvdXAKICAgICAgICAtIGRlbGV0aW9uCiAgIyBBVURJVF9ERUxfVVNFUiAtIFVzZXIgYWNjb3VudCBkZWxldGVkCiAgLSByZWNvcmRfdHlwZXM6IERFTF9VU0VSCiAgICBhY3Rpb246IGRlbGV0ZWQtdXNlci1hY2NvdW50CiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IFtpZCwgYWNjdF0KICAgICAgd2hhdDogYWNjb3VudAogICAgZWNzOgogICAgICA8PDogKmVjcy1pYW0KICAgICAgPDw6ICplY3MtdXNlci1tb2RpZmljYXRpb24tbWFwcGluZ3MKICAgICAgdHlwZToKICAgICAgICAtIHVzZXIKICAgICAgICAtIGRlbGV0aW9uCiAgIyBBVURJVF9HUlBfTUdNVCAtIEdyb3VwIGFjY291bnQgYXR0ciB3YXMgbW9kaWZpZWQKICAtIHJlY29yZF90eXBlczogR1JQX01HTVQKICAgIGFjdGlvbjogbW9kaWZpZWQtZ3JvdXAtYWNjb3VudAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbaWQsIGFjY3RdCiAgICAgIHdoYXQ6IGFjY291bnQKICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIHR5cGU6CiAgICAgICAgLSBncm91cAogICAgICAgIC0gY2hhbmdlCiAgICAgIG1hcHBpbmdzOgogICAgICAgIC0gZnJvbTogc3ViamVjdC5wcmltYXJ5CiAgICAgICAgICB0bzogdXNlcgogICAgICAgIC0gZnJvbTogc3ViamVjdC5zZWNvbmRhcnkKICAgICAgICAgIHRvOiBncm91cAogICAgICAgIC0gZnJvbTogdWlkLnVpZAogICAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCiAgIyBBVURJVF9ST0xFX0FTU0lHTiAtIEFkbWluIGFzc2lnbmVkIHVzZXIgdG8gcm9sZQogIC0gcmVjb3JkX3R5cGVzOiBST0xFX0FTU0lHTgogICAgYWN0aW9uOiBhc3NpZ25lZC11c2VyLXJvbGUtdG8KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogW2lkLCBhY2N0XQogICAgICB3aGF0OiBhY2NvdW50CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWlhbQogICAgICB0eXBlOgogICAgICAgIC0gdXNlcgogICAgICAgIC0gY2hhbmdlCiAgIyBBVURJVF9ST0xFX01PRElGWSAtIEFkbWluIG1vZGlmaWVkIGEgcm9sZQogIC0gcmVjb3JkX3R5cGVzOiBST0xFX01PRElGWQogICAgYWN0aW9uOiBtb2RpZmllZC1yb2xlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWlhbQogICAgICB0eXBlOgogICAgICAgIC0gY2hhbmdlCiAgIyBBVURJVF9ST0xFX1JFTU9WRSAtIEFkbWluIHJlbW92ZWQgdXNlciBmcm9tIHJvbGUKICAtIHJlY29yZF90eXBlczogUk9MRV9SRU1PVkUKICAgIGFjdGlvbjogcmVtb3ZlZC11c2VyLXJvbGUtZnJvbQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbaWQsIGFjY3RdCiAgICAgIHdoYXQ6IGFjY291bnQKICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIHR5cGU6CiAgICAgICAgLSB1c2VyCiAgICAgICAgLSBjaGFuZ2UKICAjIEFVRElUX1VTRVJfTUdNVCAtIFVzZXIgYWNjdCBhdHRyaWJ1dGUgY2hhbmdlCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBVU0VSX01HTVQKICAgIGFjdGlvbjogbW9kaWZpZWQtdXNlci1hY2NvdW50CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWlhbQogICAgICB0eXBlOgogICAgICAgIC0gdXNlcgogICAgICAgIC0gY2hhbmdlCiAgICAgIG1hcHBpbmdzOgogICAgICAgIC0gZnJvbTogc3ViamVjdC5wcmltYXJ5CiAgICAgICAgICB0bzogdXNlcgogICAgICAgIC0gZnJvbTogc3ViamVjdC5zZWNvbmRhcnkKICAgICAgICAgIHRvOiB1c2VyLnRhcmdldAogICAgICAgIC0gZnJvbTogdWlkLnVpZAogICAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCiAgIyBBVURJVF9VU0VSX0NIQVVUSFRPSyAtIFVzZXIgYWNjdCBwYXNzd29yZCBvciBwaW4gY2hhbmdlZAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9DSEFVVEhUT0sKICAgIGFjdGlvbjogY2hhbmdlZC1wYXNzd29yZAogICAgZWNzOgogICAgICA8PDogKmVjcy1pYW0KICAgICAgdHlwZToKICAgICAgICAtIHVzZXIKICAgICAgICAtIGNoYW5nZQogICAgICBtYXBwaW5nczoKICAgICAgICAtIGZyb206IHN1YmplY3QucHJpbWFyeQogICAgICAgICAgdG86IHVzZXIKICAgICAgICAtIGZyb206IHVpZC51aWQKICAgICAgICAgIHRvOiB1c2VyLmVmZmVjdGl2ZQogICAgICAgIC0gZnJvbTogc3ViamVjdC5zZWNvbmRhcnkKICAgICAgICAgIHRvOiB1c2VyLnRhcmdldAoKICAjIEFVRElUX0dSUF9DSEFVVEhUT0sgLSBHcm91cCBhY2N0IHBhc3N3b3JkIG9yIHBpbiBjaGFuZ2VkCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBHUlBfQ0hBVVRIVE9LCiAgICBhY3Rpb246IGNoYW5nZWQtZ3JvdXAtcGFzc3dvcmQKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogYWNjdAogICAgICB3aGF0OiB1c2VyLXNlc3Npb24KICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIHR5cGU6CiAgICAgICAgLSBncm91cAogICAgICAgIC0gY2hhbmdlCiAgICAgIG1hcHBpbmdzOgogICAgICAgIC0gZnJvbTogc3ViamVjdC5wcmltYXJ5CiAgICAgICAgICB0bzogdXNlcgogICAgICAgIC0gZnJvbTogdWlkLnVpZAogICAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCiAgICAgICAgLSBmcm9tOiBzdWJqZWN0LnNlY29uZGFyeQogICAgICAgICAgdG86IGdyb3VwCgogICMgQXV0aGVudGljYXRpb24gcmVsYXRlZCBldmVudHMKCiAgIyBBVURJVF9DUkVEX0FDUSAtIFVzZXIgY3JlZGVudGlhbCBhY3F1aXJlZAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogQ1JFRF9BQ1EKICAgIGFjdGlvbjogYWNxdWlyZWQtY3JlZGVudGlhbHMKICAgIGVjczogKmVjcy1hdXRoCiAgIyBBVURJVF9DUkVEX0RJU1AgLSBVc2VyIGNyZWRlbnRpYWwgZGlzcG9zZWQKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IENSRURfRElTUAogICAgYWN0aW9uOiBkaXNwb3NlZC1jcmVkZW50aWFscwogICAgZWNzOiAqZWNzLWF1dGgKICAjIEFVRElUX0NSRURfUkVGUiAtIFVzZXIgY3JlZGVudGlhbCByZWZyZXNoZWQKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IENSRURfUkVGUgogICAgYWN0aW9uOiByZWZyZXNoZWQtY3JlZGVudGlhbHMKICAgIGVjczogKmVjcy1hdXRoCiAgIyBBVURJVF9HUlBfQVVUSCAtIEF1dGhlbnRpY2F0aW9uIGZvciBncm91cCBwYXNzd29yZAogIC0gcmVjb3JkX3R5cGVzOiBHUlBfQVVUSAogICAgYWN0aW9uOiBhdXRoZW50aWNhdGVkLXRvLWdyb3VwCiAgICBlY3M6ICplY3MtYXV0aAogICMgQVVESVRfTE9HSU4gLSBEZWZpbmUgdGhlIGxvZ2luIGlkIGFuZCBpbmZvcm1hdGlvbgogIC0gcmVjb3JkX3R5cGVzOiBMT0dJTgogICAgYWN0aW9uOiBjaGFuZ2VkLWxvZ2luLWlkLXRvCiAgICBzdWJqZWN0OgogICAgICBwcmltYXJ5OiBbb2xkX2F1aWQsIG9sZC1hdWlkXQogICAgICBzZWNvbmRhcnk6IHVpZAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbbmV3LWF1aWQsIG5ld19hdWlkLCBhdWlkXQogICAgICB3aGF0OiB1c2VyLXNlc3Npb24KICAgIGVjczoKICAgICAgPDw6ICplY3MtYXV0aAogICAgICB0eXBlOiBzdGFydAogICAgICBtYXBwaW5nczoKICAgICAgICAtIGZyb206IHN1YmplY3QucHJpbWFyeQogICAgICAgICAgdG86IHVzZXIKICAgICAgICAtIGZyb206IG9iamVjdC5wcmltYXJ5CiAgICAgICAgICB0bzogdXNlci5lZmZlY3RpdmUKICAjIEFVRElUX1VTRVJfQUNDVCAtIFVzZXIgc3lzdGVtIGFjY2VzcyBhdXRob3JpemF0aW9uCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBVU0VSX0FDQ1QKICAgIGFjdGlvbjogd2FzLWF1dGhvcml6ZWQKICAgIGVjczogKmVjcy1hdXRoCiAgIyBBVURJVF9VU0VSX0FVVEggLSBVc2VyIHN5c3RlbSBhY2Nlc3MgYXV0aGVudGljYXRpb24KICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IFVTRVJfQVVUSAogICAgYWN0aW9uOiBhdXRoZW50aWNhdGVkCiAgICBlY3M6ICplY3MtYXV0aAogICMgQVVESVRfVVNFUl9FTkQgLSBVc2VyIHNlc3Npb24gZW5kCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBVU0VSX0VORAogICAgYWN0aW9uOiBlbmRlZC1zZXNzaW9uCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXNlc3Npb24KICAgICAgdHlwZTogZW5kCiAgIyBBVURJVF9VU0VSX0VSUiAtIFVzZXIgYWNjdCBzdGF0ZSBlcnJvcgogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9FUlIKICAgIGFjdGlvbjogZXJyb3IKICAgIHNvdXJjZV9pcDogW2FkZHJdCiAgICBlY3M6ICplY3MtYXV0aAogICMgQVVESVRfVVNFUl9MT0dJTiAtIFVzZXIgaGFzIGxvZ2dlZCBpbgogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9MT0dJTgogICAgYWN0aW9uOiBsb2dnZWQtaW4KICAgIHNvdXJjZV9pcDogW2FkZHJdCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWF1dGgKICAgICAgdHlwZTogc3RhcnQKICAjIEFVRElUX1VTRVJfTE9HT1VUIC0gVXNlciBoYXMgbG9nZ2VkIG91dAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9MT0dPVVQKICAgIGFjdGlvbjogbG9nZ2VkLW91dAogICAgZWNzOgogICAgICA8PDogKmVjcy1hdXRoCiAgICAgIHR5cGU6IGVuZAogICMgQVVESVRfVVNFUl9ST0xFX0NIQU5HRSAtIFVzZXIgY2hhbmdlZCB0byBhIG5ldyByb2xlCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBVU0VSX1JPTEVfQ0hBTkdFCiAgICBhY3Rpb246IGNoYW5nZWQtcm9sZS10bwogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBzZWxlY3RlZC1jb250ZXh0CiAgICAgIHdoYXQ6IHVzZXItc2Vzc2lvbgogICMgQVVESVRfVVNFUl9TVEFSVCAtIFVzZXIgc2Vzc2lvbiBzdGFydAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9TVEFSVAogICAgYWN0aW9uOiBzdGFydGVkLXNlc3Npb24KICAgIHNvdXJjZV9pcDogW2FkZHJdCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXNlc3Npb24KICAgICAgdHlwZTogc3RhcnQKCiAgIyBIb3N0IHZpcnR1YWxpemF0aW9uIGV2ZW50cwoKICAjIEFVRElUX1ZJUlRfQ09OVFJPTCAtIFN0YXJ0LCBQYXVzZSwgU3RvcCBWTQogIC0gcmVjb3JkX3R5cGVzOiBWSVJUX0NPTlRST0wKICAgIGFjdGlvbjogaXNzdWVkLXZtLWNvbnRyb2wKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogb3AKICAgICAgc2Vjb25kYXJ5OiB2bQogICAgICB3aGF0OiB2aXJ0dWFsLW1hY2hpbmUKICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX0NSRUFURSAtIENyZWF0aW9uIG9mIGd1ZXN0IGltYWdlCiAgLSByZWNvcmRfdHlwZXM6IFZJUlRfQ1JFQVRFCiAgICBhY3Rpb246IGNyZWF0ZWQtdm0taW1hZ2UKICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX0RFU1RST1kgLSBEZXN0cnVjdGlvbiBvZiBndWVzdCBpbWFnZQogIC0gcmVjb3JkX3R5cGVzOiBWSVJUX0RFU1RST1kKICAgIGFjdGlvbjogZGVsZXRlZC12bS1pbWFnZQogICAgZWNzOiAqZWNzLWhvc3QKICAjIEFVRElUX1ZJUlRfSU5URUdSSVRZX0NIRUNLIC0gR3Vlc3QgaW50ZWdyaXR5IHJlc3VsdHMKICAtIHJlY29yZF90eXBlczogVklSVF9JTlRFR1JJVFlfQ0hFQ0sKICAgIGFjdGlvbjogY2hlY2tlZC1pbnRlZ3JpdHktb2YKICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX01BQ0hJTkVfSUQgLSBCaW5kaW5nIG9mIGxhYmVsIHRvIFZNCiAgLSByZWNvcmRfdHlwZXM6IFZJUlRfTUFDSElORV9JRAogICAgYWN0aW9uOiBhc3NpZ25lZC12bS1pZAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiB2bQogICAgICB3aGF0OiB2aXJ0dWFsLW1hY2hpbmUKICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX01JR1JBVEVfSU4gLSBJbmJvdW5kIGd1ZXN0IG1pZ3JhdGlvbiBpbmZvCiAgLSByZWNvcmRfdHlwZXM6IFZJUlRfTUlHUkFURV9JTgogICAgYWN0aW9uOiBtaWdyYXRlZC12bS1mcm9tCiAgICBlY3M6ICplY3MtaG9zdAogICMgQVVESVRfVklSVF9NSUdSQVRFX09VVCAtIE91dGJvdW5kIGd1ZXN0IG1pZ3JhdGlvbiBpbmZvCiAgLSByZWNvcmRfdHlwZXM6IFZJUlRfTUlHUkFURV9PVVQKICAgIGFjdGlvbjogbWlncmF0ZWQtdm0tdG8KICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX1JFU09VUkNFIC0gUmVzb3VyY2UgYXNzaWdubWVudAogIC0gcmVjb3JkX3R5cGVzOiBWSVJUX1JFU09VUkNFCiAgICBhY3Rpb246IGFzc2lnbmVkLXZtLXJlc291cmNlCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IHJlc3JjCiAgICAgIHNlY29uZGFyeTogdm0KICAgICAgd2hhdDogdmlydHVhbC1tYWNoaW5lCiAgICBlY3M6ICplY3MtaG9zdAoKICAjIFVzZXJzcGFjZSBwcm9jZXNzIGV2ZW50cwoKICAjIEFVRElUX0NIR1JQX0lEIC0gVXNlciBzcGFjZSBncm91cCBJRCBjaGFuZ2VkCiAgLSByZWNvcmRfdHlwZXM6IENIR1JQX0lECiAgICBhY3Rpb246IGNoYW5nZWQtZ3JvdXAKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX0NIVVNFUl9JRCAtIENoYW5nZWQgdXNlciBJRCBzdXBwbGVtZW50YWwgZGF0YQogIC0gcmVjb3JkX3R5cGVzOiBDSFVTRVJfSUQKICAgIGFjdGlvbjogY2hhbmdlZC11c2VyLWlkCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9URVNUIC0gVXNlZCBmb3IgdGVzdCBzdWNjZXNzIG1lc3NhZ2VzCiAgLSByZWNvcmRfdHlwZXM6IFRFU1QKICAgIGFjdGlvbjogc2VudC10ZXN0CiAgICBlY3M6ICplY3MtcHJvY2VzcwogICMgQVVESVRfVFJVU1RFRF9BUFAgLSBUcnVzdGVkIGFwcCBtc2cgLSBmcmVlc3R5bGUgdGV4dAogIC0gcmVjb3JkX3R5cGVzOiBUUlVTVEVEX0FQUAogICAgYWN0aW9uOiB1bmtub3duCiAgICBlY3M6ICplY3MtcHJvY2VzcwogICMgQVVESVRfVVNFUl9DTUQgLSBVc2VyIHNoZWxsIGNvbW1hbmQgYW5kIGFyZ3MKICAtIHJlY29yZF90eXBlczogVVNFUl9DTUQKICAgIGFjdGlvbjogcmFuLWNvbW1hbmQKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogY21kCiAgICAgIHdoYXQ6IHByb2Nlc3MKICAgIGRlc2NyaXB0aW9uOiA+CiAgICAgIFRoZXNlIG1lc3NhZ2VzIGFyZSBmcm9tIHVzZXItc3BhY2UgYXBwcywgbGlrZSBzdWRvLCB0aGF0IGxvZyBjb21tYW5kcwogICAgICBiZWluZyBydW4gYnkgYSB1c2VyLiBUaGUgdWlkIGNvbnRhaW5lZCBpbiB0aGVzZSBtZXNzYWdlcyBpcyB1c2VyJ3MgVUlEIGF0CiAgICAgIHRoZSB0aW1lIHRoZSBjb21tYW5kIHdhcyBydW4uIEl0IGlzIG5vdCB0aGUgInRhcmdldCIgVUlEIHVzZWQgdG8gcnVuIHRoZQogICAgICBjb21tYW5kLCB3aGljaCBpcyBub3JtYWxseSByb290LgogICAgZWNzOgogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IHN0YXJ0CgogICMgSG9zdC1sZXZlbCBldmVudHMKCiAgIyBBVURJVF9TWVNURU1fQk9PVCAtIFN5c3RlbSBib290CiAgLSByZWNvcmRfdHlwZXM6IFNZU1RFTV9CT09UCiAgICBhY3Rpb246IGJvb3RlZC1zeXN0ZW0KICAgIG9iamVjdDoKICAgICAgd2hhdDogc3lzdGVtCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWhvc3QKICAgICAgdHlwZTogc3RhcnQKICAjIEFVRElUX1NZU1RFTV9SVU5MRVZFTCAtIFN5c3RlbSBydW5sZXZlbCBjaGFuZ2UKICAtIHJlY29yZF90eXBlczogU1lTVEVNX1JVTkxFVkVMCiAgICBhY3Rpb246IGNoYW5nZWQtdG8tcnVubGV2ZWwKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogbmV3LWxldmVsCiAgICAgIHdoYXQ6IHN5c3RlbQogICAgZWNzOgogICAgICA8PDogKmVjcy1ob3N0CiAgICAgIHR5cGU6IGNoYW5nZQogICMgQVVESVRfU1lTVEVNX1NIVVRET1dOIC0gU3lzdGVtIHNodXRkb3duCiAgLSByZWNvcmRfdHlwZXM6IFNZU1RFTV9TSFVURE9XTgogICAgYWN0aW9uOiBzaHV0ZG93bi1zeXN0ZW0KICAgIG9iamVjdDoKICAgICAgd2hhdDogc3lzdGVtCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWhvc3QKICAgICAgdHlwZTogZW5kCgogICMgU2VydmljZS1sZXZlbCBldmVudHMKCiAgIyBBVURJVF9TRVJWSUNFX1NUQVJUIC0gU2VydmljZSAoZGFlbW9uKSBzdGFydAogIC0gcmVjb3JkX3R5cGVzOiBTRVJWSUNFX1NUQVJUCiAgICBhY3Rpb246IHN0YXJ0ZWQtc2VydmljZQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiB1bml0CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBzdGFydAogICMgQVVESVRfU0VSVklDRV9TVE9QIC0gU2VydmljZSAoZGFlbW9uKSBzdG9wCiAgLSByZWNvcmRfdHlwZXM6IFNFUlZJQ0VfU1RPUAogICAgYWN0aW9uOiBzdG9wcGVkLXNlcnZpY2UKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogdW5pdAogICAgICB3aGF0OiBzZXJ2aWNlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogc3RvcAoKICAjIEF1ZGl0ZCBpbnRlcm5hbCBldmVudHMKCiAgIyBBVURJVF9DT05GSUdfQ0hBTkdFIC0gQXVkaXQgc3lzdGVtIGNvbmZpZ3VyYXRpb24gY2hhbmdlCiAgLSByZWNvcmRfdHlwZXM6IENPTkZJR19DSEFOR0UKICAgIGFjdGlvbjogY2hhbmdlZC1hdWRpdC1jb25maWd1cmF0aW9uCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6CiAgICAgICAgW29wLCBrZXksIGF1ZGl0X2VuYWJsZWQsIGF1ZGl0X3BpZCwgYXVkaXRfYmFja2xvZ19saW1pdCwgYXVkaXRfZmFpbHVyZV0KICAgICAgd2hhdDogYXVkaXQtY29uZmlnCiAgICBlY3M6CiAgICAgIGNhdGVnb3J5OiBbcHJvY2VzcywgY29uZmlndXJhdGlvbl0KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9EQUVNT05fQUJPUlQgLSBEYWVtb24gZXJyb3Igc3RvcCByZWNvcmQKICAtIHJlY29yZF90eXBlczogREFFTU9OX0FCT1JUCiAgICBhY3Rpb246IGFib3J0ZWQtYXVkaXRkLXN0YXJ0dXAKICAgIG9iamVjdDoKICAgICAgd2hhdDogc2VydmljZQogICAgZWNzOgogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IHN0b3AKICAjIEFVRElUX0RBRU1PTl9BQ0NFUFQgLSBBdWRpdGQgYWNjZXB0ZWQgcmVtb3RlIGNvbm5lY3Rpb24KICAtIHJlY29yZF90eXBlczogREFFTU9OX0FDQ0VQVAogICAgYWN0aW9uOiByZW1vdGUtYXVkaXQtY29ubmVjdGVkCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtbmV0d29yawogICAgICB0eXBlOgogICAgICAgIC0gY29ubmVjdGlvbgogICAgICAgIC0gc3RhcnQKICAjIEFVRElUX0RBRU1PTl9DTE9TRSAtIEF1ZGl0ZCBjbG9zZWQgcmVtb3RlIGNvbm5lY3Rpb24KICAtIHJlY29yZF90eXBlczogREFFTU9OX0NMT1NFCiAgICBhY3Rpb246IHJlbW90ZS1hdWRpdC1kaXNjb25uZWN0ZWQKICAgIG9iamVjdDoKICAgICAgd2hhdDogc2VydmljZQogICAgZWNzOgogICAgICA8PDogKmVjcy1uZXR3b3JrCiAgICAgIHR5cGU6CiAgICAgICAgLSBjb25uZWN0aW9uCiAgICAgICAgLSBzdGFydAogICMgQVVESVRfREFFTU9OX0NPTkZJRyAtIERhZW1vbiBjb25maWcgY2hhbmdlCiAgLSByZWNvcmRfdHlwZXM6IERBRU1PTl9DT05GSUcKICAgIGFjdGlvbjogY2hhbmdlZC1hdWRpdGQtY29uZmlndXJhdGlvbgogICAgb2JqZWN0OgogICAgICB3aGF0OiBzZXJ2aWNlCiAgICBlY3M6CiAgICAgIGNhdGVnb3J5OiBbcHJvY2VzcywgY29uZmlndXJhdGlvbl0KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9EQUVNT05fRU5EIC0gRGFlbW9uIG5vcm1hbCBzdG9wIHJlY29yZAogIC0gcmVjb3JkX3R5cGVzOiBEQUVNT05fRU5ECiAgICBhY3Rpb246IHNodXRkb3duLWF1ZGl0CiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBzdG9wCiAgIyBBVURJVF9EQUVNT05fRVJSIC0gQXVkaXRkIGludGVybmFsIGVycm9yCiAgLSByZWNvcmRfdHlwZXM6IERBRU1PTl9FUlIKICAgIGFjdGlvbjogYXVkaXQtZXJyb3IKICAgIG9iamVjdDoKICAgICAgd2hhdDogc2VydmljZQogICAgZWNzOiAqZWNzLXByb2Nlc3MKICAjIEFVRElUX0RBRU1PTl9SRUNPTkZJRyAtIEF1ZGl0ZCBzaG91bGQgcmVjb25maWd1cmUKICAtIHJlY29yZF90eXBlczogREFFTU9OX1JFQ09ORklHCiAgICBhY3Rpb246IHJlY29uZmlndXJlZC1hdWRpdGQKICAgIG9iamVjdDoKICAgICAgd2hhdDogc2VydmljZQogICAgZWNzOgogICAgICBjYXRlZ29yeTogW3Byb2Nlc3MsIGNvbmZpZ3VyYXRpb25dCiAgICAgIHR5cGU6IGluZm8KICAjIEFVRElUX0RBRU1PTl9SRVNVTUUgLSBBdWRpdGQgc2hvdWxkIHJlc3VtZSBsb2dnaW5nCiAgLSByZWNvcmRfdHlwZXM6IERBRU1PTl9SRVNVTUUKICAgIGFjdGlvbjogcmVzdW1lZC1hdWRpdC1sb2dnaW5nCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX0RBRU1PTl9ST1RBVEUgLSBBdWRpdGQgc2hvdWxkIHJvdGF0ZSBsb2dzCiAgLSByZWNvcmRfdHlwZXM6IERBRU1PTl9ST1RBVEUKICAgIGFjdGlvbjogcm90YXRlZC1hdWRpdC1sb2dzCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX0RBRU1PTl9TVEFSVCAtIERhZW1vbiBzdGFydHVwIHJlY29yZAogIC0gcmVjb3JkX3R5cGVzOiBEQUVNT05fU1RBUlQKICAgIGFjdGlvbjogc3RhcnRlZC1hdWRpdAogICAgb2JqZWN0OgogICAgICB3aGF0OiBzZXJ2aWNlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogc3RhcnQKICAjIEFVRElUX0tFUk5FTCAtIEFzeW5jaHJvbm91cyBhdWRpdCByZWNvcmQuIE5PVCBBIFJFUVVFU1QuCiAgLSByZWNvcmRfdHlwZXM6IEtFUk5FTAogICAgYWN0aW9uOiBpbml0aWFsaXplZC1hdWRpdC1zdWJzeXN0ZW0KICAgIGVjczogKmVjcy1wcm9jZXNzCgogICMgQ29uZmlndXJhdGlvbiBjaGFuZ2UgZXZlbnRzCgogICMgQVVESVRfVVNZU19DT05GSUcgLSBVc2VyIHNwYWNlIHN5c3RlbSBjb25maWcgY2hhbmdlCiAgLSByZWNvcmRfdHlwZXM6IFVTWVNfQ09ORklHCiAgICBhY3Rpb246IGNoYW5nZWQtY29uZmlndXJhdGlvbgogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBvcAogICAgICB3aGF0OiBzeXN0ZW0KICAgIGVjczoKICAgICAgY2F0ZWdvcnk6IGNvbmZpZ3VyYXRpb24KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9ORVRGSUxURVJfQ0ZHIC0gTmV0ZmlsdGVyIGNoYWluIG1vZGlmaWNhdGlvbnMKICAtIHJlY29yZF90eXBlczogTkVURklMVEVSX0NGRwogICAgYWN0aW9uOiBsb2FkZWQtZmlyZXdhbGwtcnVsZS10bwogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiB0YWJsZQogICAgICB3aGF0OiBmaXJld2FsbAogICAgZWNzOgogICAgICBjYXRlZ29yeTogY29uZmlndXJhdGlvbgogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX0ZFQVRVUkVfQ0hBTkdFIC0gYXVkaXQgbG9nIGxpc3RpbmcgZmVhdHVyZSBjaGFuZ2VzCiAgLSByZWNvcmRfdHlwZXM6IEZFQVRVUkVfQ0hBTkdFCiAgICBhY3Rpb246IGNoYW5nZWQtYXVkaXQtZmVhdHVyZQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBmZWF0dXJlCiAgICAgIHdoYXQ6IHN5c3RlbQogICAgZWNzOgogICAgICBjYXRlZ29yeTogY29uZmlndXJhdGlvbgogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX1JFUExBQ0UgLSBSZXBsYWNlIGF1ZGl0ZCBpZiB0aGlzIHBhY2tldCB1bmFuc3dlcmQKCiAgIyBUVFkgZXZlbnRzCgogIC0gcmVjb3JkX3R5cGVzOgogICAgICAjIEFVRElUX1RUWSAtIElucHV0IG9uIGFuIGFkbWluaXN0cmF0aXZlIFRUWQogICAgICAtIFRUWQogICAgICAjIEFVRElUX1VTRVJfVFRZIC0gTm9uLUlDQU5PTiBUVFkgaW5wdXQgbWVhbmluZwogICAgICAtIFVTRVJfVFRZCiAgICBhY3Rpb246IHR5cGVkCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IGRhdGEKICAgICAgd2hhdDoga2V5c3Ryb2tlcwogICAgaG93OiBbY29tbSwgZXhlXQoKICAjIFBvbGljeSBldmVudHMKCiAgIyBBVURJVF9BVkMgLSBTRSBMaW51eCBhdmMgZGVuaWFsIG9yIGdyYW50IChzZWxpbnV4KQogIC0gcmVjb3JkX3R5cGVzOiBBVkMKICAgIGFjdGlvbjogdmlvbGF0ZWQtc2VsaW51eC1wb2xpY3kKICAgIHN1YmplY3Q6CiAgICAgIHByaW1hcnk6IHNjb250ZXh0CiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IHRjb250ZXh0CiAgICAgIHNlY29uZGFyeTogdGNsYXNzCiAgICBoYXNfZmllbGRzOgogICAgICAtIHNlcmVzdWx0CiAgIyBBVURJVF9BVkMgLSBTRSBMaW51eCBhdmMgZGVuaWFsIG9yIGdyYW50IChhcHBhcm1vcikKICAtIHJlY29yZF90eXBlczogQVZDCiAgICBhY3Rpb246IHZpb2xhdGVkLWFwcGFybW9yLXBvbGljeQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBvcGVyYXRpb24KICAgICAgc2Vjb25kYXJ5OiBbcmVxdWVzdGVkX21hc2ssIGRlbmllZF9tYXNrLCBjYXBuYW1lXQogICAgICB3aGF0OiBwb2xpY3kKICAgIGhhc19maWVsZHM6CiAgICAgIC0gYXBwYXJtb3IKICAjIEFVRElUX0ZTX1JFTEFCRUwgLSBGaWxlc3lzdGVtIHJlbGFiZWxlZAogIC0gcmVjb3JkX3R5cGVzOiBGU19SRUxBQkVMCiAgICBhY3Rpb246IHJlbGFiZWxlZC1maWxlc3lzdGVtCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IG1hYy1jb25maWcKICAjIEFVRElUX0xBQkVMX0xFVkVMX0NIQU5HRSAtIE9iamVjdCdzIGxldmVsIHdhcyBjaGFuZ2VkCiAgLSByZWNvcmRfdHlwZXM6IExBQkVMX0xFVkVMX0NIQU5HRQogICAgYWN0aW9uOiBtb2RpZmllZC1sZXZlbC1vZgogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBwcmludGVyCiAgICAgIHdoYXQ6IHByaW50ZXIKICAjIEFVRElUX0xBQkVMX09WRVJSSURFIC0gQWRtaW4gaXMgb3ZlcnJpZGluZyBhIGxhYmVsCiAgLSByZWNvcmRfdHlwZXM6IExBQkVMX09WRVJSSURFCiAgICBhY3Rpb246IG92ZXJyb2RlLWxhYmVsLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IG1hYy1jb25maWcKICAjIEFVRElUX01BQ19DSEVDSyAtIFVzZXIgc3BhY2UgTUFDIGRlY2lzaW9uIHJlc3VsdHMKICAtIHJlY29yZF90eXBlczogTUFDX0NIRUNLCiAgICBhY3Rpb246IG1hYy1wZXJtaXNzaW9uCiAgIyBBVURJVF9NQUNfQ09ORklHX0NIQU5HRSAtIENoYW5nZXMgdG8gYm9vbGVhbnMKICAtIHJlY29yZF90eXBlczogTUFDX0NPTkZJR19DSEFOR0UKICAgIGFjdGlvbjogY2hhbmdlZC1zZWxpbnV4LWJvb2xlYW4KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogYm9vbAogICAgICB3aGF0OiBtYWMtY29uZmlnCiAgICBlY3M6CiAgICAgIGNhdGVnb3J5OiBjb25maWd1cmF0aW9uCiAgICAgIHR5cGU6IGNoYW5nZQogICMgQVVESVRfTUFDX1BPTElDWV9MT0FEIC0gUG9saWN5IGZpbGUgbG9hZAogIC0gcmVjb3JkX3R5cGVzOiBNQUNfUE9MSUNZX0xPQUQKICAgIGFjdGlvbjogbG9hZGVkLXNlbGludXgtcG9saWN5CiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IG1hYy1jb25maWcKICAgIGVjczoKICAgICAgY2F0ZWdvcnk6IGNvbmZpZ3VyYXRpb24KICAgICAgdHlwZTogYWNjZXNzCiAgIyBBVURJVF9NQUNfU1RBVFVTIC0gQ2hhbmdlZCBlbmZvcmNpbmcscGVybWlzc2l2ZSxvZmYKICAtIHJlY29yZF90eXBlczogTUFDX1NUQVRVUwogICAgYWN0aW9uOiBjaGFuZ2VkLXNlbGludXgtZW5mb3JjZW1lbnQKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogZW5mb3JjaW5nCiAgICAgIHdoYXQ6IG1hYy1jb25maWcKICAgIGVjczoKICAgICAgY2F0ZWdvcnk6IGNvbmZpZ3VyYXRpb24KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9VU0VSX0FWQyAtIFVzZXIgc3BhY2UgYXZjIG1lc3NhZ2UKICAtIHJlY29yZF90eXBlczogVVNFUl9BVkMKICAgIGFjdGlvbjogYWNjZXNzLXBlcm1pc3Npb24KICAjIEFVRElUX1VTRVJfTUFDX0NPTkZJR19DSEFOR0UgLSBDaGFuZ2UgbWFkZSB0byBNQUMgcG9saWN5CiAgLSByZWNvcmRfdHlwZXM6IFVTRVJfTUFDX0NPTkZJR19DSEFOR0UKICAgIGFjdGlvbjogY2hhbmdlZC1tYWMtY29uZmlndXJhdGlvbgogICAgb2JqZWN0OgogICAgICB3aGF0OiBtYWMtY29uZmlnCiAgICBlY3M6CiAgICAgIGNhdGVnb3J5OiBjb25maWd1cmF0aW9uCiAgICAgIHR5cGU6IGNoYW5nZQogICMgQVVESVRfVVNFUl9NQUNfUE9MSUNZX0xPQUQgLSBVc2Vyc3BjIGRhZW1vbiBsb2FkZWQgcG9saWMKICAtIHJlY29yZF90eXBlczogVVNFUl9NQUNfUE9MSUNZX0xPQUQKICAgIGFjdGlvbjogbG9hZGVkLW1hYy1wb2xpY3kKICAgIG9iamVjdDoKICAgICAgd2hhdDogbWFjLWNvbmZpZwogICAgZWNzOgogICAgICBjYXRlZ29yeTogY29uZmlndXJhdGlvbgogICAgICB0eXBlOiBhY2Nlc3MKICAjIEFVRElUX1VTRVJfU0VMSU5VWF9FUlIgLSBTRSBMaW51eCB1c2VyIHNwYWNlIGVycm9yCiAgLSByZWNvcmRfdHlwZXM6IFVTRVJfU0VMSU5VWF9FUlIKICAgIGFjdGlvbjogYWNjZXNzLWVycm9yCiAgIyBBVURJVF9TRUNDT01QIC0gU2VjdXJlIENvbXB1dGluZyBldmVudAogIC0gcmVjb3JkX3R5cGVzOiBTRUNDT01QCiAgICBhY3Rpb246IHZpb2xhdGVkLXNlY2NvbXAtcG9saWN5CiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IHN5c2NhbGwKICAgICAgd2hhdDogcHJvY2VzcwogICMgQVVESVRfU0VMSU5VWF9FUlIgLSBJbnRlcm5hbCBTRSBMaW51eCBFcnJvcnMKICAtIGFjdGlvbjogY2F1c2VkLW1hYy1wb2xpY3ktZXJyb3IKICAgIG9iamVjdDoKICAgICAgd2hhdDogc3lzdGVtCiAgICByZWNvcmRfdHlwZXM6IFNFTElOVVhfRVJSCiAgIyBBVURJVF9BUFBBUk1PUl9BTExPV0VECiAgIyBBVURJVF9BUFBBUk1PUl9ERU5JRUQKICAjIEFVRElUX0FQUEFSTU9SX0VSUk9SCiAgIyBBVURJVF9BVkNfUEFUSCAtIGRlbnRyeSwgdmZzbW91bnQgcGFpciBmcm9tIGF2YwogICMgQVVESVRfQVBQQVJNT1JfQVVESVQKICAjIEFVRElUX0FQUEFSTU9SX0hJTlQKICAjIEFVRElUX0FQUEFSTU9SX1NUQVRVUwogICMgQVVESVRfQVBQQVJNT1JfRVJST1IKICAjIEFVRElUX0RFVl9BTExPQyAtIERldmljZSB3YXMgYWxsb2NhdGVkCiAgIyBBVURJVF9ERVZfREVBTExPQyAtIERldmljZSB3YXMgZGVhbGxvY2F0ZWQKICAjIEFVRElUX01BQ19VTkxCTF9BTExPVyAtIE5ldExhYmVsOiBhbGxvdyB1bmxhYmVsZWQgdHJhZmZpYwogICMgQVVESVRfTUFDX0NJUFNPVjRfQUREIC0gTmV0TGFiZWw6IGFkZCBDSVBTT3Y0IERPSSBlbnRyeQogICMgQVVESVRfTUFDX0NJUFNPVjRfREVMIC0gTmV0TGFiZWw6IGRlbCBDSVBTT3Y0IERPSSBlbnRyeQogICMgQVVESVRfTUFDX01BUF9BREQgLSBOZXRMYWJlbDogYWRkIExTTSBkb21haW4gbWFwcGluZwogICMgQVVESVRfTUFDX01BUF9ERUwgLSBOZXRMYWJlbDogZGVsIExTTSBkb21haW4gbWFwcGluZwogICMgQVVESVRfTUFDX0lQU0VDX0VWRU5UIC0gQXVkaXQgYW4gSVBTZWMgZXZlbnQKICAjIEFVRElUX01BQ19VTkxCTF9TVENBREQgLSBOZXRMYWJlbDogYWRkIGEgc3RhdGljIGxhYmVsCiAgIyBBVURJVF9NQUNfVU5MQkxfU1RDREVMIC0gTmV0TGFiZWw6IGRlbCBhIHN0YXRpYyBsYWJlbAogICMgQVVESVRfTUFDX0NBTElQU09fQUREIC0gTmV0TGFiZWw6IGFkZCBDQUxJUFNPIERPSSBlbnRyeQogICMgQVVESVRfTUFDX0NBTElQU09fREVMIC0gTmV0TGFiZWw6IGRlbCBDQUxJUFNPIERPSSBlbnRyeQogICMgQVVESVRfVVNFUl9MQUJFTEVEX0VYUE9SVCAtIE9iamVjdCBleHBvcnRlZCB3aXRoIGxhYmVsCiAgIyBBVURJVF9VU0VSX1VOTEFCRUxFRF9FWFBPUlQgLSBPYmplY3QgZXhwb3J0ZWQgd2l0aG91dCBsYWJlbAoKICAjIENyeXB0byBldmVudHMKCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgYWN0aW9uOiBuZWdvdGlhdGVkLWNyeXB0by1rZXkKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogZnAKICAgICAgc2Vjb25kYXJ5OiBbYWRkciwgaG9zdG5hbWVdCiAgICAgIHdoYXQ6IHVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBDUllQVE9fS0VZX1VTRVIKICAgIHNvdXJjZV9pcDogW2FkZHJdCiAgICBlY3M6ICplY3MtcHJvY2VzcwogIC0gYWN0aW9uOiBjcnlwdG8tb2ZmaWNlci1sb2dnZWQtaW4KICAgIHJlY29yZF90eXBlczogQ1JZUFRPX0xPR0lOCiAgLSBhY3Rpb246IGNyeXB0by1vZmZpY2VyLWxvZ2dlZC1vdXQKICAgIHJlY29yZF90eXBlczogQ1JZUFRPX0xPR09VVAogICAgZWNzOiAqZWNzLXByb2Nlc3MKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICBhY3Rpb246IHN0YXJ0ZWQtY3J5cHRvLXNlc3Npb24KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogYWRkcgogICAgICBzZWNvbmRhcnk6IFtycG9ydF0KICAgIHJlY29yZF90eXBlczogQ1JZUFRPX1NFU1NJT04KICAgIHNvdXJjZV9pcDogW2FkZHJdCiAgICBlY3M6ICplY3MtcHJvY2VzcwogIC0gYWN0aW9uOiBhY2Nlc3MtcmVzdWx0CiAgICByZWNvcmRfdHlwZXM6IERBQ19DSEVDSwoKICAjIEFub21hbGllcwoKICAjIEFVRElUX0FOT01fQUJFTkQgLSBQcm9jZXNzIGVuZGVkIGFibm9ybWFsbHkKICAtIHJlY29yZF90eXBlczogQU5PTV9BQkVORAogICAgYWN0aW9uOiBjcmFzaGVkLXByb2dyYW0KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogW2NvbW0sIGV4ZV0KICAgICAgc2Vjb25kYXJ5OiBwaWQKICAgICAgd2hhdDogcHJvY2VzcwogICAgaG93OiBzaWcKICAgIGVjczoKICAgICAgIyBjb25zaWRlciBhZGRpbmcgYW4gYW5vbWFseSBjYXRlZ29yeSB3aGVuIHdlIGludHJvZHVjZQogICAgICAjIHRvIEVDUwogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IGVuZAogICMgQVVESVRfQU5PTV9FWEVDIC0gRXhlY3V0aW9uIG9mIGZpbGUKICAtIHJlY29yZF90eXBlczogQU5PTV9FWEVDCiAgICBhY3Rpb246IGF0dGVtcHRlZC1leGVjdXRpb24tb2YtZm9yYmlkZGVuLXByb2dyYW0KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogY21kCiAgICAgIHdoYXQ6IHByb2Nlc3MKICAgIGhvdzogdGVybWluYWwKICAgIGVjczoKICAgICAgIyBjb25zaWRlciBhZGRpbmcgYW4gYW5vbWFseSBjYXRlZ29yeSB3aGVuIHdlIGludHJvZHVjZQogICAgICAjIHRvIEVDUwogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IHN0YXJ0CiAgIyBBVURJVF9BTk9NX0xJTksgLSBTdXNwaWNpb3VzIHVzZSBvZiBmaWxlIGxpbmtzCiAgLSByZWNvcmRfdHlwZXM6IEFOT01fTElOSwogICAgYWN0aW9uOiB1c2VkLXN1c3BpY2lvdXMtbGluawogICMgQVVESVRfQU5PTV9MT0dJTl9GQUlMVVJFUyAtIEZhaWxlZCBsb2dpbiBsaW1pdCByZWFjaGVkCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBBTk9NX0xPR0lOX0ZBSUxVUkVTCiAgICBhY3Rpb246IGZhaWxlZC1sb2ctaW4tdG9vLW1hbnktdGltZXMtdG8KICAjIEFVRElUX0FOT01fTE9HSU5fTE9DQVRJT04gLSBMb2dpbiBmcm9tIGZvcmJpZGRlbiBsb2NhdGlvbgogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogQU5PTV9MT0dJTl9MT0NBVElPTgogICAgYWN0aW9uOiBhdHRlbXB0ZWQtbG9nLWluLWZyb20tdW51c3VhbC1wbGFjZS10bwogICMgQVVESVRfQU5PTV9MT0dJTl9TRVNTSU9OUyAtIE1heCBjb25jdXJyZW50IHNlc3Npb25zIHJlYWNoZWQKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IEFOT01fTE9HSU5fU0VTU0lPTlMKICAgIGFjdGlvbjogb3BlbmVkLXRvby1tYW55LXNlc3Npb25zLXRvCiAgIyBBVURJVF9BTk9NX0xPR0lOX1RJTUUgLSBMb2dpbiBhdHRlbXB0ZWQgYXQgYmFkIHRpbWUKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IEFOT01fTE9HSU5fVElNRQogICAgYWN0aW9uOiBhdHRlbXB0ZWQtbG9nLWluLWR1cmluZy11bnVzdWFsLWhvdXItdG8KICAjIEFVRElUX0FOT01fUFJPTUlTQ1VPVVMgLSBEZXZpY2UgY2hhbmdlZCBwcm9taXNjdW91cyBtb2RlCiAgLSByZWNvcmRfdHlwZXM6IEFOT01fUFJPTUlTQ1VPVVMKICAgIGFjdGlvbjogY2hhbmdlZC1wcm9taXNjdW91cy1tb2RlLW9uLWRldmljZSAjIENvdWxkIGJlIGVudGVyZWQgb3IgZXhpdGVkIGJhc2VkIG9uIHByb20gZmllbGQuCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IGRldgogICAgICB3aGF0OiBuZXR3b3JrLWRldmljZQogICMgQVVESVRfQU5PTV9SQkFDX0lOVEVHUklUWV9GQUlMIC0gUkJBQyBmaWxlIGludGVncml0eSBmYWlsdXJlCiAgLSByZWNvcmRfdHlwZXM6IEFOT01fUkJBQ19JTlRFR1JJVFlfRkFJTAogICAgYWN0aW9uOiB0ZXN0ZWQtZmlsZS1zeXN0ZW0taW50ZWdyaXR5LW9mCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IGhvc3RuYW1lCiAgICAgIHdoYXQ6IGZpbGVzeXN0ZW0KICAjIEFVRElUX0FOT01fTE9HSU5fQUNDVCAtIExvZ2luIGF0dGVtcHRlZCB0byB3YXRjaGVkIGFjY3QKICAjIEFVRElUX0FOT01fTUFYX0RBQyAtIE1heCBEQUMgZmFpbHVyZXMgcmVhY2hlZAogICMgQVVESVRfQU5PTV9NQVhfTUFDIC0gTWF4IE1BQyBmYWlsdXJlcyByZWFjaGVkCiAgIyBBVURJVF9BTk9NX0FNVFVfRkFJTCAtIEFNVFUgZmFpbHVyZQogICMgQVVESVRfQU5PTV9SQkFDX0ZBSUwgLSBSQkFDIHNlbGYgdGVzdCBmYWlsdXJlCiAgIyBBVURJVF9BTk9NX0NSWVBUT19GQUlMIC0gQ3J5cHRvIHN5c3RlbSB0ZXN0IGZhaWx1cmUKICAjIEFVRElUX0FOT01fTUtfRVhFIC0gTWFrZSBhbiBleGVjdXRhYmxlCiAgIyBBVURJVF9BTk9NX0FDQ0VTU19GUyAtIEFjY2VzcyBvZiBmaWxlIG9yIGRpcgogICMgQVVESVRfQU5PTV9BRERfQUNDVCAtIEFkZGluZyBhbiBhY2N0CiAgIyBBVURJVF9BTk9NX0RFTF9BQ0NUIC0gRGVsZXRpbmcgYW4gYWNjdAogICMgQVVESVRfQU5PTV9NT0RfQUNDVCAtIENoYW5naW5nIGFuIGFjY3QKICAjIEFVRElUX0FOT01fUk9PVF9UUkFOUyAtIFVzZXIgYmVjYW1lIHJvb3QKICAjIEFVRElUX0FOT01fTE9HSU5fU0VSVklDRSAtIFNlcnZpY2UgYWNjdCBhdHRlbXB0ZWQgbG9naW4KCiAgIyBBbm9tYWx5IHJlc3BvbnNlcwoKICAjIEFVRElUX1JFU1BfQU5PTUFMWSAtIEFub21hbHkgbm90IHJlYWN0ZWQgdG8KICAjIEFVRElUX1JFU1BfQUxFUlQgLSBBbGVydCBlbWFpbCB3YXMgc2VudAogICMgQVVESVRfUkVTUF9LSUxMX1BST0MgLSBLaWxsIHByb2dyYW0KICAjIEFVRElUX1JFU1BfVEVSTV9BQ0NFU1MgLSBUZXJtaW5hdGUgc2Vzc2lvbgogICMgQVVESVRfUkVTUF9BQ0NUX1JFTU9URSAtIEFjY3QgbG9ja2VkIGZyb20gcmVtb3RlIGFjY2VzcwogICMgQVVESVRfUkVTUF9BQ0NUX0xPQ0tfVElNRUQgLSBVc2VyIGFjY3QgbG9ja2VkIGZvciB0aW1lCiAgIyBBVURJVF9SRVNQX0FDQ1RfVU5MT0NLX1RJTUVEIC0gVXNlciBhY2N0IHVubG9ja2VkIGZyb20gdGltZQogICMgQVVESVRfUkVTUF9BQ0NUX0xPQ0sgLSBVc2VyIGFjY3Qgd2FzIGxvY2tlZAogICMgQVVESVRfUkVTUF9URVJNX0xPQ0sgLSBUZXJtaW5hbCB3YXMgbG9ja2VkCiAgIyBBVURJVF9SRVNQX1NFQk9PTCAtIFNldCBhbiBTRSBMaW51eCBib29sZWFuCiAgIyBBVURJVF9SRVNQX0VYRUMgLSBFeGVjdXRlIGEgc2NyaXB0CiAgIyBBVURJVF9SRVNQX1NJTkdMRSAtIEdvIHRvIHNpbmdsZSB1c2VyIG1vZGUKICAjIEFVRElUX1JFU1BfSEFMVCAtIHRha2UgdGhlIHN5c3RlbSBkb3duCiAgIyBBVURJVF9SRVNQX09SSUdJTl9CTE9DSyAtIEFkZHJlc3MgYmxvY2tlZCBieSBpcHRhYmxlcwogICMgQVVESVRfUkVTUF9PUklHSU5fQkxPQ0tfVElNRUQgLSBBZGRyZXNzIGJsb2NrZWQgZm9yIHRpbWUKCiAgIyBBdWRpdCBydWxlIGV2ZW50cwoKICAjIEFVRElUX1NZU0NBTEwgLSBTeXNjYWxsIGV2ZW50CiAgIyBBVURJVF9QQVRIIC0gRmlsZW5hbWUgcGF0aCBpbmZvcm1hdGlvbgogICMgQVVESVRfSVBDIC0gSVBDIHJlY29yZAogICMgQVVESVRfU09DS0VUQ0FMTCAtIHN5c19zb2NrZXRjYWxsIGFyZ3VtZW50cwogICMgQVVESVRfU09DS0FERFIgLSBzb2NrYWRkciBjb3BpZWQgYXMgc3lzY2FsbCBhcmcKICAjIEFVRElUX0NXRCAtIEN1cnJlbnQgd29ya2luZyBkaXJlY3RvcnkKICAjIEFVRElUX0VYRUNWRSAtIGV4ZWN2ZSBhcmd1bWVudHMKICAjIEFVRElUX0lQQ19TRVRfUEVSTSAtIElQQyBuZXcgcGVybWlzc2lvbnMgcmVjb3JkIHR5cGUKICAjIEFVRElUX01RX09QRU4gLSBQT1NJWCBNUSBvcGVuIHJlY29yZCB0eXBlCiAgIyBBVURJVF9NUV9TRU5EUkVDVi0gUE9TSVggTVEgc2VuZC9yZWNlaXZlIHJlY29yZCB0eXBlCiAgIyBBVURJVF9NUV9OT1RJRlkgLSBQT1NJWCBNUSBub3RpZnkgcmVjb3JkIHR5cGUKICAjIEFVRElUX01RX0dFVFNFVEFUVFIgLSBQT1NJWCBNUSBnZXQvc2V0IGF0dHJpYnV0ZSByZWNvcmQgdHlwZQogICMgQVVESVRfRkRfUEFJUiAtIGF1ZGl0IHJlY29yZCBmb3IgcGlwZS9zb2NrZXRwYWlyCiAgIyBBVURJVF9PQkpfUElEIC0gcHRyYWNlIHRhcmdldAogICMgQVVESVRfQlBSTV9GQ0FQUyAtIEluZm9ybWF0aW9uIGFib3V0IGZjYXBzIGluY3JlYXNpbmcgcGVybXMKICAjIEFVRElUX0NBUFNFVCAtIFJlY29yZCBzaG93aW5nIGFyZ3VtZW50IHRvIHN5c19jYXBzZXQKICAjIEFVRElUX01NQVAgLSBSZWNvcmQgc2hvd2luZyBkZXNjcmlwdG9yIGFuZCBmbGFncyBpbiBtbWFwCiAgIyBBVURJVF9ORVRGSUxURVJfUEtUIC0gUGFja2V0cyB0cmF2ZXJzaW5nIG5ldGZpbHRlciBjaGFpbnMKCiAgIyBJbnRlZ3JpdHkgY2hlY2tzCgogICMgQVVESVRfSU5URUdSSVRZX0RBVEEgLSBEYXRhIGludGVncml0eSB2ZXJpZmljYXRpb24KICAjIEFVRElUX0lOVEVHUklUWV9NRVRBREFUQSAtIE1ldGFkYXRhIGludGVncml0eSB2ZXJpZmljYXRpb24KICAjIEFVRElUX0lOVEVHUklUWV9TVEFUVVMgLSBJbnRlZ3JpdHkgZW5hYmxlIHN0YXR1cwogICMgQVVESVRfSU5URUdSSVRZX0hBU0ggLSBJbnRlZ3JpdHkgSEFTSCB0eXBlCiAgIyBBVURJVF9JTlRFR1JJVFlfUENSIC0gUENSIGludmFsaWRhdGlvbiBtc2dzCiAgIyBBVURJVF9JTlRFR1JJVFlfUlVMRSAtIFBvbGljeSBydWxlCgogICMgVmFyaW91cwoKICAjIEFVRElUX1VTRVIgLSBNZXNzYWdlIGZyb20gdXNlcnNwYWNlIC0tIGRlcHJlY2F0ZWQKICAtIHJlY29yZF90eXBlczogVVNFUgogICAgYWN0aW9uOiBzZW50LW1lc3NhZ2UKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogYWRkcgoKICAjIEFVRElUX1NPRlRXQVJFX1VQREFURSAtIFBhY2thZ2UgbWFuYWdlbWVudAogIC0gcmVjb3JkX3R5cGVzOiBTT0ZUV0FSRV9VUERBVEUKICAgIGFjdGlvbjogcGFja2FnZS11cGRhdGVkCiAgICBlY3M6CiAgICAgIGNhdGVnb3J5OiBwYWNrYWdlCiAgICAgIHR5cGU6IGluZm8K")
+		value, _ = base64.StdEncoding.DecodeString("LS0tCiMgTWFjcm9zIGRlY2xhcmVzIHNvbWUgWUFNTCBhbmNob3JzIHRoYXQgY2FuIGJlIHJlZmVyZW5jZWQgZm9yIHNvbWUgY29tbW9uCiMgb2JqZWN0IHR5cGUgbm9ybWFsaXphdGlvbnMgbGlrZSB1c2VyLXNlc3Npb24sIHNvY2tldCwgb3IgcHJvY2Vzcy4KbWFjcm9zOgogIC0gJmRlZmF1bHRzCiAgICBzdWJqZWN0OgogICAgICBwcmltYXJ5OiBhdWlkCiAgICAgIHNlY29uZGFyeTogdWlkCiAgICBob3c6IFtleGUsIGNvbW1dCgogIC0gJm1hY3JvLXVzZXItc2Vzc2lvbgogICAgc3ViamVjdDoKICAgICAgcHJpbWFyeTogYXVpZAogICAgICBzZWNvbmRhcnk6IFthY2N0LCBpZCwgdWlkXQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiB0ZXJtaW5hbAogICAgICBzZWNvbmRhcnk6IFthZGRyLCBob3N0bmFtZV0KICAgICAgd2hhdDogdXNlci1zZXNzaW9uCiAgICBob3c6IFtleGUsIHRlcm1pbmFsXQoKICAtICZtYWNyby1zb2NrZXQKICAgIDw8OiAqZGVmYXVsdHMKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogW2FkZHIsIHBhdGhdCiAgICAgIHNlY29uZGFyeTogcG9ydAogICAgICB3aGF0OiBzb2NrZXQKCiAgLSAmbWFjcm8tcHJvY2VzcwogICAgPDw6ICpkZWZhdWx0cwogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbY21kLCBleGUsIGNvbW1dCiAgICAgIHNlY29uZGFyeTogcGlkCiAgICAgIHdoYXQ6IHByb2Nlc3MKICAgIGhvdzogdGVybWluYWwKCiAgLSAmZWNzLWlhbQogICAgY2F0ZWdvcnk6IGlhbQogICAgdHlwZTogaW5mbwoKICAtICZlY3MtYXV0aAogICAgY2F0ZWdvcnk6IGF1dGhlbnRpY2F0aW9uCiAgICB0eXBlOiBpbmZvCiAgICBtYXBwaW5nczoKICAgICAgLSBmcm9tOiBzdWJqZWN0LnByaW1hcnkKICAgICAgICB0bzogdXNlcgogICAgICAtIGZyb206IHN1YmplY3Quc2Vjb25kYXJ5CiAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCgogIC0gJmVjcy1zZXNzaW9uCiAgICBjYXRlZ29yeTogc2Vzc2lvbgogICAgdHlwZTogaW5mbwogICAgbWFwcGluZ3M6CiAgICAgIC0gZnJvbTogc3ViamVjdC5wcmltYXJ5CiAgICAgICAgdG86IHVzZXIKICAgICAgLSBmcm9tOiBzdWJqZWN0LnNlY29uZGFyeQogICAgICAgIHRvOiB1c2VyLmVmZmVjdGl2ZQoKICAtICZlY3MtaG9zdAogICAgY2F0ZWdvcnk6IGhvc3QKICAgIHR5cGU6IGluZm8KCiAgLSAmZWNzLXByb2Nlc3MKICAgIGNhdGVnb3J5OiBwcm9jZXNzCiAgICB0eXBlOiBpbmZvCgogIC0gJmVjcy1maWxlCiAgICBjYXRlZ29yeTogZmlsZQogICAgdHlwZTogaW5mbwoKICAtICZlY3MtZHJpdmVyCiAgICBjYXRlZ29yeTogZHJpdmVyCiAgICB0eXBlOiBpbmZvCgogIC0gJmVjcy1uZXR3b3JrCiAgICBjYXRlZ29yeTogbmV0d29yawogICAgdHlwZToKICAgICAgLSBjb25uZWN0aW9uCiAgICAgIC0gaW5mbwoKICAtICZlY3MtdXNlci1tb2RpZmljYXRpb24tbWFwcGluZ3MKICAgIG1hcHBpbmdzOgogICAgICAtIGZyb206IHN1YmplY3QucHJpbWFyeQogICAgICAgIHRvOiB1c2VyCiAgICAgIC0gZnJvbTogc3ViamVjdC5zZWNvbmRhcnkKICAgICAgICB0bzogdXNlci5lZmZlY3RpdmUKICAgICAgLSBmcm9tOiBvYmplY3QucHJpbWFyeQogICAgICAgIHRvOiB1c2VyLnRhcmdldAoKICAtICZlY3MtZ3JvdXAtbW9kaWZpY2F0aW9uLW1hcHBpbmdzCiAgICBtYXBwaW5nczoKICAgICAgLSBmcm9tOiBzdWJqZWN0LnByaW1hcnkKICAgICAgICB0bzogdXNlcgogICAgICAtIGZyb206IHN1YmplY3Quc2Vjb25kYXJ5CiAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCiAgICAgIC0gZnJvbTogb2JqZWN0LnByaW1hcnkKICAgICAgICB0bzogZ3JvdXAKCiMgTm9ybWFsaXphdGlvbnMgaXMgYSBsaXN0IG9mIGRlY2xhcmF0aW9ucyBzcGVjaWZ5aW5nIGhvdyB0byBub3JtYWxpemUgdGhlIGRhdGEKIyBjb250YWluZWQgaW4gYW4gZXZlbnQuIFRoZSBub3JtYWxpemF0aW9uIGNhbiBiZSBhcHBsaWVkIGJhc2VkIG9uIHRoZSBzeXNjYWxsCiMgbmFtZSAoZS5nLiBjb25uZWN0LCBvcGVuKSBvciBiYXNlZCBvbiB0aGUgcmVjb3JkIHR5cGUgKGUuZy4gVVNFUl9MT0dJTikuCiMgTm8gdHdvIG5vcm1hbGl6YXRpb25zIGNhbiBhcHBseSB0byB0aGUgc2FtZSBzeXNjYWxsIG9yIHJlY29yZCB0eXBlLiBUaGlzCiMgd2lsbCByZXN1bHQgaW4gYSBmYWlsdXJlIGF0IGxvYWQgdGltZS4KIwojIEVhY2ggbm9ybWFsaXphdGlvbiBzaG91bGQgc3BlY2lmeToKIyAgIGFjdGlvbiAtIHdoYXQgaGFwcGVuZWQKIyAgIGFjdG9yICAtIHdobyBkaWQgdGhpcyBvciB3aG8gdHJpZ2dlcmVkIHRoZSBldmVudAojICAgb2JqZWN0IC0gd2hhdCB3YXMgdGhlICJ0aGluZyIgaW52b2x2ZWQgaW4gdGhlIGFjdGlvbiAoZS5nLiBwcm9jZXNzLCBzb2NrZXQpCiMgICBob3cgICAgLSBob3cgd2FzIHRoZSBhY3Rpb24gcGVyZm9ybWVkIChlLmcuIGV4ZSBvciB0ZXJtaW5hbCkKbm9ybWFsaXphdGlvbnM6CiAgLSBlY3M6ICplY3MtcHJvY2VzcwogICAgc3lzY2FsbHM6CiAgICAgIC0gJyonICMgdGhpcyBpcyBhIGNhdGNoIGFsbAogIC0gYWN0aW9uOiBvcGVuZWQtZmlsZQogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBjcmVhdCAtIG9wZW4gYW5kIHBvc3NpYmx5IGNyZWF0ZSBhIGZpbGUKICAgICAgLSBjcmVhdAogICAgZWNzOgogICAgICA8PDogKmVjcy1maWxlCiAgICAgIHR5cGU6IGNyZWF0aW9uCiAgLSBhY3Rpb246IG9wZW5lZC1maWxlCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIGZhbGxvY2F0ZSAtIG1hbmlwdWxhdGUgZmlsZSBzcGFjZQogICAgICAtIGZhbGxvY2F0ZQogICAgICAjIHRydW5jYXRlIC0gdHJ1bmNhdGUgYSBmaWxlIHRvIGEgc3BlY2lmaWVkIGxlbmd0aAogICAgICAtIHRydW5jYXRlCiAgICAgICMgZnRydW5jYXRlIC0gdHJ1bmNhdGUgYSBmaWxlIHRvIGEgc3BlY2lmaWVkIGxlbmd0aAogICAgICAtIGZ0cnVuY2F0ZQogICAgZWNzOgogICAgICA8PDogKmVjcy1maWxlCiAgICAgICMgdGVjaG5pY2FsbHkgeW91IGNhbiB0cnVuY2F0ZSBhIGZpbGUgdG8gdGhlIHNhbWUgbGVuZ3RoCiAgICAgICMgYnV0IHJlZ2FyZGxlc3MsIHdlIGNvbnNpZGVyIHRoaXMgYSBjaGFuZ2UKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IG9wZW5lZC1maWxlCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIG9wZW4gLSBvcGVuIGFuZCBwb3NzaWJseSBjcmVhdGUgYSBmaWxlCiAgICAgIC0gb3BlbgogICAgICAjIG9wZW5hdCAtIG9wZW4gYW5kIHBvc3NpYmx5IGNyZWF0ZSBhIGZpbGUKICAgICAgLSBvcGVuYXQKICAgICAgIyByZWFkbGluayAtIHJlYWQgdmFsdWUgb2YgYSBzeW1ib2xpYyBsaW5rCiAgICAgIC0gcmVhZGxpbmsKICAgICAgIyByZWFkbGlua2F0IC0gcmVhZCB2YWx1ZSBvZiBhIHN5bWJvbGljIGxpbmsKICAgICAgLSByZWFkbGlua2F0CiAgICBlY3M6ICplY3MtZmlsZQogIC0gYWN0aW9uOiByZWFkLWZpbGUKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgc3lzY2FsbHM6CiAgICAgICMgcmVhZCAtIHJlYWQgZnJvbSBhIGZpbGUgZGVzY3JpcHRvcgogICAgICAtIHJlYWQKICAgIGVjczogKmVjcy1maWxlCiAgLSBhY3Rpb246IHdyb3RlLXRvLWZpbGUKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgc3lzY2FsbHM6CiAgICAgICMgd3JpdGUgLSB3cml0ZSB0byBhIGZpbGUgZGVzY3JpcHRvcgogICAgICAtIHdyaXRlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IGNoYW5nZWQtZmlsZS1hdHRyaWJ1dGVzLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIHNldHhhdHRyIC0gc2V0IGFuIGV4dGVuZGVkIGF0dHJpYnV0ZSB2YWx1ZQogICAgICAtIHNldHhhdHRyCiAgICAgICMgZnNldHhhdHRyIC0gc2V0IGFuIGV4dGVuZGVkIGF0dHJpYnV0ZSB2YWx1ZQogICAgICAtIGZzZXR4YXR0cgogICAgICAjIGxzZXR4YXR0ciAtIHNldCBhbiBleHRlbmRlZCBhdHRyaWJ1dGUgdmFsdWUKICAgICAgLSBsc2V0eGF0dHIKICAgICAgIyByZW1vdmV4YXR0ciAtIHJlbW92ZSBhbiBleHRlbmRlZCBhdHRyaWJ1dGUKICAgICAgLSByZW1vdmV4YXR0cgogICAgICAjIGZyZW1vdmV4YXR0ciAtIHJlbW92ZSBhbiBleHRlbmRlZCBhdHRyaWJ1dGUKICAgICAgLSBmcmVtb3ZleGF0dHIKICAgICAgIyBscmVtb3ZleGF0dHIgLSByZW1vdmUgYW4gZXh0ZW5kZWQgYXR0cmlidXRlCiAgICAgIC0gbHJlbW92ZXhhdHRyCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IGNoYW5nZWQtZmlsZS1wZXJtaXNzaW9ucy1vZgogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBjaG1vZCAtIGNoYW5nZSBwZXJtaXNzaW9ucyBvZiBhIGZpbGUKICAgICAgLSBjaG1vZAogICAgICAjIGZjaG1vZCAtIGNoYW5nZSBwZXJtaXNzaW9ucyBvZiBhIGZpbGUKICAgICAgLSBmY2htb2QKICAgICAgIyBmY2htb2RhdCAtIGNoYW5nZSBwZXJtaXNzaW9ucyBvZiBhIGZpbGUKICAgICAgLSBmY2htb2RhdAogICAgZWNzOgogICAgICA8PDogKmVjcy1maWxlCiAgICAgIHR5cGU6IGNoYW5nZQogIC0gYWN0aW9uOiBjaGFuZ2VkLWZpbGUtb3duZXJzaGlwLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIGNob3duIC0gY2hhbmdlIG93bmVyc2hpcCBvZiBhIGZpbGUKICAgICAgLSBjaG93bgogICAgICAjIGZjaG93biAtIGNoYW5nZSBvd25lcnNoaXAgb2YgYSBmaWxlCiAgICAgIC0gZmNob3duCiAgICAgICMgZmNob3duYXQgLSBjaGFuZ2Ugb3duZXJzaGlwIG9mIGEgZmlsZQogICAgICAtIGZjaG93bmF0CiAgICAgICMgbGNob3duIC0gY2hhbmdlIG93bmVyc2hpcCBvZiBhIGZpbGUKICAgICAgLSBsY2hvd24KICAgIGVjczoKICAgICAgPDw6ICplY3MtZmlsZQogICAgICB0eXBlOiBjaGFuZ2UKICAtIGFjdGlvbjogbG9hZGVkLWtlcm5lbC1tb2R1bGUKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgICBwcmltYXJ5OiBuYW1lCiAgICByZWNvcmRfdHlwZXM6CiAgICAgIC0gS0VSTl9NT0RVTEUKICAgIHN5c2NhbGxzOgogICAgICAjIGZpbml0X21vZHVsZSAtIGxvYWQgYSBrZXJuZWwgbW9kdWxlCiAgICAgIC0gZmluaXRfbW9kdWxlCiAgICAgICMgaW5pdF9tb2R1bGUgLSBsb2FkIGEga2VybmVsIG1vZHVsZQogICAgICAtIGluaXRfbW9kdWxlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWRyaXZlcgogICAgICB0eXBlOiBzdGFydAogIC0gYWN0aW9uOiB1bmxvYWRlZC1rZXJuZWwtbW9kdWxlCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIGRlbGV0ZV9tb2R1bGUgLSB1bmxvYWQgYSBrZXJuZWwgbW9kdWxlCiAgICAgIC0gZGVsZXRlX21vZHVsZQogICAgZWNzOgogICAgICA8PDogKmVjcy1kcml2ZXIKICAgICAgdHlwZTogZW5kCiAgLSBhY3Rpb246IGNyZWF0ZWQtZGlyZWN0b3J5CiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgICAgcGF0aF9pbmRleDogMQogICAgc3lzY2FsbHM6CiAgICAgICMgbWtkaXIgLSBjcmVhdGUgYSBkaXJlY3RvcnkKICAgICAgLSBta2RpcgogICAgICAjIG1rZGlyYXQgLSBjcmVhdGUgYSBkaXJlY3RvcnkKICAgICAgLSBta2RpcmF0CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgdHlwZTogY3JlYXRpb24KICAtIGFjdGlvbjogbW91bnRlZAogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlc3lzdGVtCiAgICAgIHBhdGhfaW5kZXg6IDEKICAgIHN5c2NhbGxzOgogICAgICAjIG1vdW50IC0gbW91bnQgZmlsZXN5c3RlbQogICAgICAtIG1vdW50CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgIyBzaW5jZSBhIG5ldyBtb3VudCBhcHBlYXJzIG9uIHRoZSBzeXN0ZW0KICAgICAgIyB3ZSBjb25zaWRlciB0aGlzIGEgaGlnaC1sZXZlbCAiY3JlYXRpb24iIGV2ZW50CiAgICAgIHR5cGU6IGNyZWF0aW9uCiAgLSBhY3Rpb246IHJlbmFtZWQKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgICBwYXRoX2luZGV4OiAyCiAgICBzeXNjYWxsczoKICAgICAgIyByZW5hbWUgLSBjaGFuZ2UgdGhlIG5hbWUgb3IgbG9jYXRpb24gb2YgYSBmaWxlCiAgICAgIC0gcmVuYW1lCiAgICAgICMgcmVuYW1lYXQgLSBjaGFuZ2UgdGhlIG5hbWUgb3IgbG9jYXRpb24gb2YgYSBmaWxlCiAgICAgIC0gcmVuYW1lYXQKICAgICAgIyByZW5hbWVhdDIgLSBjaGFuZ2UgdGhlIG5hbWUgb3IgbG9jYXRpb24gb2YgYSBmaWxlCiAgICAgIC0gcmVuYW1lYXQyCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IGNoZWNrZWQtbWV0YWRhdGEtb2YKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICAgc3lzY2FsbHM6CiAgICAgICMgYWNjZXNzIC0gY2hlY2sgdXNlcidzIHBlcm1pc3Npb25zIGZvciBhIGZpbGUKICAgICAgLSBhY2Nlc3MKICAgICAgIyBmYWNjZXNzYXQgLSBjaGVjayB1c2VyJ3MgcGVybWlzc2lvbnMgZm9yIGEgZmlsZQogICAgICAtIGZhY2Nlc3NhdAogICAgICAjIGZzdGF0YXQgLSBnZXQgZmlsZSBzdGF0dXMKICAgICAgLSBmc3RhdGF0CiAgICAgICMgbmV3ZnN0YXRhdCAtIGdldCBmaWxlIHN0YXR1cwogICAgICAtIG5ld2ZzdGF0YXQKICAgICAgIyBzdGF0IC0gZ2V0IGZpbGUgc3RhdHVzCiAgICAgIC0gc3RhdAogICAgICAjIHN0YXQ2NCAtIGdldCBmaWxlIHN0YXR1cwogICAgICAtIHN0YXQ2NAogICAgICAjIGZzdGF0IC0gZ2V0IGZpbGUgc3RhdHVzCiAgICAgIC0gZnN0YXQKICAgICAgIyBsc3RhdCAtIGdldCBmaWxlIHN0YXR1cwogICAgICAtIGxzdGF0CiAgICAgICMgZ2V0eGF0dHIgLSByZXRyaWV2ZSBhbiBleHRlbmRlZCBhdHRyaWJ1dGUgdmFsdWUKICAgICAgLSBnZXR4YXR0cgogICAgICAjIGxnZXR4YXR0ciAtIHJldHJpZXZlIGFuIGV4dGVuZGVkIGF0dHJpYnV0ZSB2YWx1ZQogICAgICAtIGxnZXR4YXR0cgogICAgICAjIGZnZXR4YXR0ciAtIHJldHJpZXZlIGFuIGV4dGVuZGVkIGF0dHJpYnV0ZSB2YWx1ZQogICAgICAtIGZnZXR4YXR0cgogICAgZWNzOiAqZWNzLWZpbGUKICAtIGFjdGlvbjogY2hlY2tlZC1maWxlc3lzdGVtLW1ldGFkYXRhLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGVzeXN0ZW0KICAgIHN5c2NhbGxzOgogICAgICAjIHN0YXRmcyAtIGdldCBmaWxlc3lzdGVtIHN0YXRpc3RpY3MKICAgICAgLSBzdGF0ZnMKICAgICAgIyBmc3RhdGZzIC0gZ2V0IGZpbGVzeXN0ZW0gc3RhdGlzdGljcwogICAgICAtIGZzdGF0ZnMKICAgIGVjczogKmVjcy1maWxlCiAgLSBhY3Rpb246IHN5bWxpbmtlZAogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBzeW1saW5rIC0gbWFrZSBhIG5ldyBuYW1lIGZvciBhIGZpbGUKICAgICAgLSBzeW1saW5rCiAgICAgICMgc3ltbGlua2F0IC0gbWFrZSBhIG5ldyBuYW1lIGZvciBhIGZpbGUKICAgICAgLSBzeW1saW5rYXQKICAgIGVjczoKICAgICAgPDw6ICplY3MtZmlsZQogICAgICAjICJjcmVhdGlvbiIgc2luY2Ugd2UncmUgY3JlYXRpbmcgYSBuZXcgZmlsZSBzeXN0ZW0KICAgICAgIyBlbnRyeSBmb3IgdGhlIHN5bWxpbmsKICAgICAgdHlwZTogY3JlYXRpb24KICAtIGFjdGlvbjogdW5tb3VudGVkCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGVzeXN0ZW0KICAgIHN5c2NhbGxzOgogICAgICAjIHVtb3VudCAtIHVubW91bnQgZmlsZXN5c3RlbQogICAgICAtIHVtb3VudAogICAgICAjIHVtb3VudDIgLSB1bm1vdW50IGZpbGVzeXN0ZW0KICAgICAgLSB1bW91bnQyCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWZpbGUKICAgICAgIyAiZGVsZXRpb24iIHRvIG1pcnJvciB0aGUgImNyZWF0aW9uIiBvZiB0aGUgbW91bnQKICAgICAgdHlwZTogZGVsZXRpb24KICAtIGFjdGlvbjogZGVsZXRlZAogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBybWRpciAtIGRlbGV0ZSBhIGRpcmVjdG9yeQogICAgICAtIHJtZGlyCiAgICAgICMgdW5saW5rIC0gZGVsZXRlIGEgbmFtZSBhbmQgcG9zc2libHkgdGhlIGZpbGUgaXQgcmVmZXJzIHRvCiAgICAgIC0gdW5saW5rCiAgICAgICMgdW5saW5rYXQgLSBkZWxldGUgYSBuYW1lIGFuZCBwb3NzaWJseSB0aGUgZmlsZSBpdCByZWZlcnMgdG8KICAgICAgLSB1bmxpbmthdAogICAgZWNzOgogICAgICA8PDogKmVjcy1maWxlCiAgICAgIHR5cGU6IGRlbGV0aW9uCiAgLSBhY3Rpb246IGNoYW5nZWQtdGltZXN0YW1wLW9mCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIHV0aW1lIC0gY2hhbmdlIGZpbGUgbGFzdCBhY2Nlc3MgYW5kIG1vZGlmaWNhdGlvbiB0aW1lcwogICAgICAtIHV0aW1lCiAgICAgICMgdXRpbWVzIC0gY2hhbmdlIGZpbGUgbGFzdCBhY2Nlc3MgYW5kIG1vZGlmaWNhdGlvbiB0aW1lcwogICAgICAtIHV0aW1lcwogICAgICAjIGZ1dGltZXNhdCAtIGNoYW5nZSB0aW1lc3RhbXBzIG9mIGEgZmlsZSByZWxhdGl2ZSB0byBhIFwgZGlyZWN0b3J5IGZpbGUgZGVzY3JpcHRvcgogICAgICAtIGZ1dGltZXNhdAogICAgICAjIGZ1dGltZW5zIC0gY2hhbmdlIGZpbGUgdGltZXN0YW1wcyB3aXRoIG5hbm9zZWNvbmQgcHJlY2lzaW9uCiAgICAgIC0gZnV0aW1lbnMKICAgICAgIyB1dGltZW5zYXQgLSBjaGFuZ2UgZmlsZSB0aW1lc3RhbXBzIHdpdGggbmFub3NlY29uZCBwcmVjaXNpb24KICAgICAgLSB1dGltZW5zYXQKICAgIGVjczogKmVjcy1maWxlCiAgLSBhY3Rpb246IGV4ZWN1dGVkCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IGZpbGUKICAgIHN5c2NhbGxzOgogICAgICAjIGV4ZWN2ZSAtIGV4ZWN1dGUgcHJvZ3JhbQogICAgICAtIGV4ZWN2ZQogICAgICAjIGV4ZWN2ZWF0IC0gZXhlY3V0ZSBwcm9ncmFtIHJlbGF0aXZlIHRvIGEgZGlyZWN0b3J5IGZpbGUgZGVzY3JpcHRvcgogICAgICAtIGV4ZWN2ZWF0CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogc3RhcnQKICAtIGFjdGlvbjogbGlzdGVuLWZvci1jb25uZWN0aW9ucwogICAgb2JqZWN0OgogICAgICB3aGF0OiBzb2NrZXQKICAgIHN5c2NhbGxzOgogICAgICAjIGxpc3Rlbi0gbGlzdGVuIGZvciBjb25uZWN0aW9ucyBvbiBhIHNvY2tldAogICAgICAtIGxpc3RlbgogICAgZWNzOgogICAgICA8PDogKmVjcy1uZXR3b3JrCiAgICAgIHR5cGU6IHN0YXJ0CiAgLSBhY3Rpb246IGFjY2VwdGVkLWNvbm5lY3Rpb24tZnJvbQogICAgb2JqZWN0OgogICAgICB3aGF0OiBzb2NrZXQKICAgIHN5c2NhbGxzOgogICAgICAjIGFjY2VwdCAtIGFjY2VwdCBhIGNvbm5lY3Rpb24gb24gYSBzb2NrZXQKICAgICAgLSBhY2NlcHQKICAgICAgIyBhY2NlcHQ0IC0gYWNjZXB0IGEgY29ubmVjdGlvbiBvbiBhIHNvY2tldAogICAgICAtIGFjY2VwdDQKICAgIGVjczoKICAgICAgPDw6ICplY3MtbmV0d29yawogICAgICB0eXBlOgogICAgICAgIC0gY29ubmVjdGlvbgogICAgICAgIC0gc3RhcnQKICAtIGFjdGlvbjogYm91bmQtc29ja2V0CiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNvY2tldAogICAgc3lzY2FsbHM6CiAgICAgICMgYmluZCAtYmluZCBhIG5hbWUgdG8gYSBzb2NrZXQKICAgICAgLSBiaW5kCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLW5ldHdvcmsKICAgICAgdHlwZTogc3RhcnQKICAtIGFjdGlvbjogY29ubmVjdGVkLXRvCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNvY2tldAogICAgc3lzY2FsbHM6CiAgICAgIC0gY29ubmVjdAogICAgZWNzOgogICAgICA8PDogKmVjcy1uZXR3b3JrCiAgICAgIHR5cGU6CiAgICAgICAgLSBjb25uZWN0aW9uCiAgICAgICAgLSBzdGFydAogIC0gYWN0aW9uOiByZWNlaXZlZC1mcm9tCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNvY2tldAogICAgc3lzY2FsbHM6CiAgICAgICMgcmVjdiAtIHJlY2VpdmUgYSBtZXNzYWdlIGZyb20gYSBzb2NrZXQKICAgICAgLSByZWN2CiAgICAgICMgcmVjdmZyb20gLSByZWNlaXZlIGEgbWVzc2FnZSBmcm9tIGEgc29ja2V0CiAgICAgIC0gcmVjdmZyb20KICAgICAgIyByZWN2bXNnIC0gcmVjZWl2ZSBhIG1lc3NhZ2UgZnJvbSBhIHNvY2tldAogICAgICAtIHJlY3Ztc2cKICAgICAgIyByZWN2bW1zZyAtIHJlY2VpdmUgbXVsdGlwbGUgbWVzc2FnZXMgb24gYSBzb2NrZXQKICAgICAgLSByZWN2bW1zZwogICAgZWNzOgogICAgICA8PDogKmVjcy1uZXR3b3JrCiAgLSBhY3Rpb246IHNlbnQtdG8KICAgIG9iamVjdDoKICAgICAgd2hhdDogc29ja2V0CiAgICBzeXNjYWxsczoKICAgICAgIyBzZW5kIC0gc2VuZCBhIG1lc3NhZ2Ugb24gYSBzb2NrZXQKICAgICAgLSBzZW5kCiAgICAgICMgc2VuZHRvIC0gc2VuZCBhIG1lc3NhZ2Ugb24gYSBzb2NrZXQKICAgICAgLSBzZW5kdG8KICAgICAgIyBzZW5kbXNnIC0gc2VuZCBhIG1lc3NhZ2Ugb24gYSBzb2NrZXQKICAgICAgLSBzZW5kbXNnCiAgICAgICMgc2VuZG1tc2cgLSBzZW5kIG11bHRpcGxlIG1lc3NhZ2VzIG9uIGEgc29ja2V0CiAgICAgIC0gc2VuZG1tc2cKICAgIGVjczoKICAgICAgPDw6ICplY3MtbmV0d29yawogIC0gYWN0aW9uOiBraWxsZWQtcGlkCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHByb2Nlc3MKICAgIHN5c2NhbGxzOgogICAgICAjIGtpbGwgLSBzZW5kIHNpZ25hbCB0byBhIHByb2Nlc3MKICAgICAgLSBraWxsCiAgICAgICMgdGtpbGwgLSBzZW5kIGEgc2lnbmFsIHRvIGEgdGhyZWFkCiAgICAgIC0gdGtpbGwKICAgICAgIyB0Z2tpbGwgLSBzZW5kIGEgc2lnbmFsIHRvIGEgdGhyZWFkCiAgICAgIC0gdGdraWxsCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogZW5kCiAgLSBhY3Rpb246IGNoYW5nZWQtaWRlbnRpdHktb2YKICAgIG9iamVjdDoKICAgICAgd2hhdDogcHJvY2VzcwogICAgaG93OiBzeXNjYWxsCiAgICBzeXNjYWxsczoKICAgICAgIyBzZXR1aWQgLSBzZXQgdXNlciBpZGVudGl0eQogICAgICAtIHNldHVpZAogICAgICAjIHNldGV1aWQgLSBzZXQgZWZmZWN0aXZlIHVzZXIgb3IgZ3JvdXAgSUQKICAgICAgLSBzZXRldWlkCiAgICAgICMgc2V0ZnN1aWQgLSBzZXQgdXNlciBpZGVudGl0eSB1c2VkIGZvciBmaWxlc3lzdGVtIGNoZWNrcwogICAgICAtIHNldGZzdWlkCiAgICAgICMgc2V0cmV1aWQgLSBzZXQgcmVhbCBhbmQvb3IgZWZmZWN0aXZlIHVzZXIgb3IgZ3JvdXAgSUQKICAgICAgLSBzZXRyZXVpZAogICAgICAjIHNldGdpZCAtIHNldCBncm91cCBpZGVudGl0eQogICAgICAtIHNldGdpZAogICAgICAjIHNldGVnaWQgLSBzZXQgZWZmZWN0aXZlIHVzZXIgb3IgZ3JvdXAgSUQKICAgICAgLSBzZXRlZ2lkCiAgICAgICMgc2V0ZnNnaWQgLSBzZXQgZ3JvdXAgaWRlbnRpdHkgdXNlZCBmb3IgZmlsZXN5c3RlbSBjaGVja3MKICAgICAgLSBzZXRmc2dpZAogICAgICAjIHNldHJlZ2lkIC0gc2V0IHJlYWwgYW5kL29yIGVmZmVjdGl2ZSB1c2VyIG9yIGdyb3VwIElECiAgICAgIC0gc2V0cmVnaWQKICAgICAgIyBzZXRyZXN1aWQgLSBzZXQgcmVhbCwgZWZmZWN0aXZlIGFuZCBzYXZlZCB1c2VyIG9yIGdyb3VwIElECiAgICAgIC0gc2V0cmVzdWlkCiAgICAgICMgc2V0cmVzZ2lkIC0gc2V0IHJlYWwsIGVmZmVjdGl2ZSBhbmQgc2F2ZWQgdXNlciBvciBncm91cCBJRAogICAgICAtIHNldHJlc2dpZAogICAgZWNzOgogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IGNoYW5nZQogIC0gYWN0aW9uOiBjaGFuZ2VkLXN5c3RlbS10aW1lCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHN5c3RlbQogICAgc3lzY2FsbHM6CiAgICAgICMgc2V0dGltZW9mZGF5IC0gZ2V0IC8gc2V0IHRpbWUKICAgICAgLSBzZXR0aW1lb2ZkYXkKICAgICAgIyBjbG9ja19zZXR0aW1lIC0gY2xvY2sgYW5kIHRpbWUgZnVuY3Rpb25zCiAgICAgIC0gY2xvY2tfc2V0dGltZQogICAgICAjIHN0aW1lIC0gc2V0IHRpbWUKICAgICAgLSBzdGltZQogICAgICAjIGFkanRpbWV4IC0gdHVuZSBrZXJuZWwgY2xvY2sKICAgICAgLSBhZGp0aW1leAogICAgZWNzOgogICAgICA8PDogKmVjcy1ob3N0CiAgICAgIHR5cGU6IGNoYW5nZQogIC0gYWN0aW9uOiBtYWtlLWRldmljZQogICAgb2JqZWN0OgogICAgICB3aGF0OiBmaWxlCiAgICBzeXNjYWxsczoKICAgICAgIyBta25vZCAtIGNyZWF0ZSBhIHNwZWNpYWwgb3Igb3JkaW5hcnkgZmlsZQogICAgICAtIG1rbm9kCiAgICAgICMgbWtub2RhdCAtIGNyZWF0ZSBhIHNwZWNpYWwgb3Igb3JkaW5hcnkgZmlsZQogICAgICAtIG1rbm9kYXQKICAgIGVjczoKICAgICAgPDw6ICplY3MtZmlsZQogICAgICB0eXBlOiBjcmVhdGlvbgogIC0gYWN0aW9uOiBjaGFuZ2VkLXN5c3RlbS1uYW1lCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHN5c3RlbQogICAgc3lzY2FsbHM6CiAgICAgICMgc2V0aG9zdG5hbWUgLSBnZXQvc2V0IGhvc3RuYW1lCiAgICAgIC0gc2V0aG9zdG5hbWUKICAgICAgIyBzZXRkb21haW5uYW1lIC0gZ2V0L3NldCBOSVMgZG9tYWluIG5hbWUKICAgICAgLSBzZXRkb21haW5uYW1lCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWhvc3QKICAgICAgdHlwZTogY2hhbmdlCiAgLSBhY3Rpb246IGFsbG9jYXRlZC1tZW1vcnkKICAgIG9iamVjdDoKICAgICAgd2hhdDogbWVtb3J5CiAgICBzeXNjYWxsczoKICAgICAgIyBtbWFwIC0gbWFwIG9yIHVubWFwIGZpbGVzIG9yIGRldmljZXMgaW50byBtZW1vcnkKICAgICAgLSBtbWFwCiAgICAgICMgbW1hcDIgLSBtYXAgZmlsZXMgb3IgZGV2aWNlcyBpbnRvIG1lbW9yeQogICAgICAtIG1tYXAyCiAgICAgICMgYnJrIC0gY2hhbmdlIGRhdGEgc2VnbWVudCBzaXplCiAgICAgIC0gYnJrCiAgICBlY3M6ICplY3MtcHJvY2VzcwogIC0gYWN0aW9uOiBhZGp1c3RlZC1zY2hlZHVsaW5nLXBvbGljeS1vZgogICAgb2JqZWN0OgogICAgICB3aGF0OiBwcm9jZXNzCiAgICBob3c6IHN5c2NhbGwKICAgIHN5c2NhbGxzOgogICAgICAjIHNjaGVkX3NldHBhcmFtIC0gc2V0IGFuZCBnZXQgc2NoZWR1bGluZyBwYXJhbWV0ZXJzCiAgICAgIC0gc2NoZWRfc2V0cGFyYW0KICAgICAgIyBzY2hlZF9zZXRzY2hlZHVsZXIgLSBzZXQgYW5kIGdldCBzY2hlZHVsaW5nIHBvbGljeS9wYXJhbWV0ZXJzCiAgICAgIC0gc2NoZWRfc2V0c2NoZWR1bGVyCiAgICAgICMgc2NoZWRfc2V0YXR0ciAtIHNldCBhbmQgZ2V0IHNjaGVkdWxpbmcgcG9saWN5IGFuZCBhdHRyaWJ1dGVzCiAgICAgIC0gc2NoZWRfc2V0YXR0cgogICAgZWNzOgogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IGNoYW5nZQoKICAjIEN1cnJlbnRseSB1bmhhbmRsZWQKICAjIHRoaXMgbGlzdCBjb21lcyBmcm9tIHBhcnNpbmcgbGludXggbWFuIHBhZ2VzIGF0IGh0dHBzOi8vZ2l0Lmtlcm5lbC5vcmcvcHViL3NjbS9kb2NzL21hbi1wYWdlcy9tYW4tcGFnZXMuZ2l0CgogICMgc2lnYWN0aW9uIC0gZXhhbWluZSBhbmQgY2hhbmdlIGEgc2lnbmFsIGFjdGlvbgogICMgcnRfc2lnYWN0aW9uIC0gZXhhbWluZSBhbmQgY2hhbmdlIGEgc2lnbmFsIGFjdGlvbgogICMgcHJlYWQgLSByZWFkIGZyb20gb3Igd3JpdGUgdG8gYSBmaWxlIGRlc2NyaXB0b3IgYXQgYSBnaXZlbiBvZmZzZXQKICAjIHB3cml0ZSAtIHJlYWQgZnJvbSBvciB3cml0ZSB0byBhIGZpbGUgZGVzY3JpcHRvciBhdCBhIGdpdmVuIG9mZnNldAogICMgczM5MF9ndWFyZGVkX3N0b3JhZ2UgLSBvcGVyYXRpb25zIHdpdGggei9BcmNoaXRlY3R1cmUgZ3VhcmRlZCBzdG9yYWdlIGZhY2lsaXR5CiAgIyBzY2hlZF9nZXRhdHRyIC0gc2V0IGFuZCBnZXQgc2NoZWR1bGluZyBwb2xpY3kgYW5kIGF0dHJpYnV0ZXMKICAjIGdldHJ1c2FnZSAtIGdldCByZXNvdXJjZSB1c2FnZQogICMgZmxvY2sgLSBhcHBseSBvciByZW1vdmUgYW4gYWR2aXNvcnkgbG9jayBvbiBhbiBvcGVuIGZpbGUKICAjIHBpZGZkX2dldGZkIC0gb2J0YWluIGEgZHVwbGljYXRlIG9mIGFub3RoZXIgcHJvY2VzcydzIGZpbGUgZGVzY3JpcHRvcgogICMgY2xvY2tfbmFub3NsZWVwIC0gaGlnaC1yZXNvbHV0aW9uIHNsZWVwIHdpdGggc3BlY2lmaWFibGUgY2xvY2sKICAjIGdldHBhZ2VzaXplIC0gZ2V0IG1lbW9yeSBwYWdlIHNpemUKICAjIHBpZGZkX29wZW4gLSBvYnRhaW4gYSBmaWxlIGRlc2NyaXB0b3IgdGhhdCByZWZlcnMgdG8gYSBwcm9jZXNzCiAgIyBzcGxpY2UgLSBzcGxpY2UgZGF0YSB0by9mcm9tIGEgcGlwZQogICMgZ2V0cmVzdWlkIC0gZ2V0IHJlYWwsIGVmZmVjdGl2ZSBhbmQgc2F2ZWQgdXNlci9ncm91cCBJRHMKICAjIGdldHJlc2dpZCAtIGdldCByZWFsLCBlZmZlY3RpdmUgYW5kIHNhdmVkIHVzZXIvZ3JvdXAgSURzCiAgIyByZW1hcF9maWxlX3BhZ2VzIC0gY3JlYXRlIGEgbm9ubGluZWFyIGZpbGUgbWFwcGluZwogICMgaW9fY2FuY2VsIC0gY2FuY2VsIGFuIG91dHN0YW5kaW5nIGFzeW5jaHJvbm91cyBJL08gb3BlcmF0aW9uCiAgIyBwcmN0bCAtIG9wZXJhdGlvbnMgb24gYSBwcm9jZXNzIG9yIHRocmVhZAogICMgY2xvY2tfZ2V0cmVzIC0gY2xvY2sgYW5kIHRpbWUgZnVuY3Rpb25zCiAgIyBjbG9ja19nZXR0aW1lIC0gY2xvY2sgYW5kIHRpbWUgZnVuY3Rpb25zCiAgIyBnZXRncm91cHMgLSBnZXQvc2V0IGxpc3Qgb2Ygc3VwcGxlbWVudGFyeSBncm91cCBJRHMKICAjIHNldGdyb3VwcyAtIGdldC9zZXQgbGlzdCBvZiBzdXBwbGVtZW50YXJ5IGdyb3VwIElEcwogICMgdm1zcGxpY2UgLSBzcGxpY2UgdXNlciBwYWdlcyB0by9mcm9tIGEgcGlwZQogICMgZXBvbGxfY3JlYXRlIC0gb3BlbiBhbiBlcG9sbCBmaWxlIGRlc2NyaXB0b3IKICAjIGVwb2xsX2NyZWF0ZTEgLSBvcGVuIGFuIGVwb2xsIGZpbGUgZGVzY3JpcHRvcgogICMgcG9zaXhfZmFkdmlzZSAtIHByZWRlY2xhcmUgYW4gYWNjZXNzIHBhdHRlcm4gZm9yIGZpbGUgZGF0YQogICMgc2lnd2FpdGluZm8gLSBzeW5jaHJvbm91c2x5IHdhaXQgZm9yIHF1ZXVlZCBzaWduYWxzCiAgIyBzaWd0aW1lZHdhaXQgLSBzeW5jaHJvbm91c2x5IHdhaXQgZm9yIHF1ZXVlZCBzaWduYWxzCiAgIyBydF9zaWd0aW1lZHdhaXQgLSBzeW5jaHJvbm91c2x5IHdhaXQgZm9yIHF1ZXVlZCBzaWduYWxzCiAgIyBzaWdyZXR1cm4gLSByZXR1cm4gZnJvbSBzaWduYWwgaGFuZGxlciBhbmQgY2xlYW51cCBzdGFjayBmcmFtZQogICMgcnRfc2lncmV0dXJuIC0gcmV0dXJuIGZyb20gc2lnbmFsIGhhbmRsZXIgYW5kIGNsZWFudXAgc3RhY2sgZnJhbWUKICAjIGdldHJhbmRvbSAtIG9idGFpbiBhIHNlcmllcyBvZiByYW5kb20gYnl0ZXMKICAjIGtleWN0bCAtIG1hbmlwdWxhdGUgdGhlIGtlcm5lbCdzIGtleSBtYW5hZ2VtZW50IGZhY2lsaXR5CiAgIyBzY2hlZF9nZXRzY2hlZHVsZXIgLSBzZXQgYW5kIGdldCBzY2hlZHVsaW5nIHBvbGljeS9wYXJhbWV0ZXJzCiAgIyBtYmluZCAtIHNldCBtZW1vcnkgcG9saWN5IGZvciBhIG1lbW9yeSByYW5nZQogICMgZ2V0cHJpb3JpdHkgLSBnZXQvc2V0IHByb2dyYW0gc2NoZWR1bGluZyBwcmlvcml0eQogICMgc2V0cHJpb3JpdHkgLSBnZXQvc2V0IHByb2dyYW0gc2NoZWR1bGluZyBwcmlvcml0eQogICMgaW5vdGlmeV9pbml0IC0gaW5pdGlhbGl6ZSBhbiBpbm90aWZ5IGluc3RhbmNlCiAgIyBpbm90aWZ5X2luaXQxIC0gaW5pdGlhbGl6ZSBhbiBpbm90aWZ5IGluc3RhbmNlCiAgIyBzZW1jdGwgLSBTeXN0ZW0gViBzZW1hcGhvcmUgY29udHJvbCBvcGVyYXRpb25zCiAgIyBnZXRob3N0bmFtZSAtIGdldC9zZXQgaG9zdG5hbWUKICAjIHB0cmFjZSAtIHByb2Nlc3MgdHJhY2UKICAjIHN5c2N0bCAtIHJlYWQvd3JpdGUgc3lzdGVtIHBhcmFtZXRlcnMKICAjIGdldHBlZXJuYW1lIC0gZ2V0IG5hbWUgb2YgY29ubmVjdGVkIHBlZXIgc29ja2V0CiAgIyBmb3JrIC0gY3JlYXRlIGEgY2hpbGQgcHJvY2VzcwogICMgcXVlcnlfbW9kdWxlIC0gcXVlcnkgdGhlIGtlcm5lbCBmb3IgdmFyaW91cyBiaXRzIHBlcnRhaW5pbmcgdG8gbW9kdWxlcwogICMgaW9jdGxfbGlzdCAtIGxpc3Qgb2YgaW9jdGwgY2FsbHMgaW4gTGludXgvaTM4NiBrZXJuZWwKICAjIG1zZ2dldCAtIGdldCBhIFN5c3RlbSBWIG1lc3NhZ2UgcXVldWUgaWRlbnRpZmllcgogICMgc3B1X2NyZWF0ZSAtIGNyZWF0ZSBhIG5ldyBzcHUgY29udGV4dAogICMgc3VicGFnZV9wcm90IC0gZGVmaW5lIGEgc3VicGFnZSBwcm90ZWN0aW9uIGZvciBhbiBhZGRyZXNzIHJhbmdlCiAgIyBxdW90YWN0bCAtIG1hbmlwdWxhdGUgZGlzayBxdW90YXMKICAjIG5mc3NlcnZjdGwgLSBzeXNjYWxsIGludGVyZmFjZSB0byBrZXJuZWwgbmZzIGRhZW1vbgogICMgb3BlbmF0MiAtIG9wZW4gYW5kIHBvc3NpYmx5IGNyZWF0ZSBhIGZpbGUgKGV4dGVuZGVkKQogICMgZ2V0cmxpbWl0IC0gZ2V0L3NldCByZXNvdXJjZSBsaW1pdHMKICAjIHNldHJsaW1pdCAtIGdldC9zZXQgcmVzb3VyY2UgbGltaXRzCiAgIyBwcmxpbWl0IC0gZ2V0L3NldCByZXNvdXJjZSBsaW1pdHMKICAjIHRlZSAtIGR1cGxpY2F0aW5nIHBpcGUgY29udGVudAogICMgc2htZ2V0IC0gYWxsb2NhdGVzIGEgU3lzdGVtIFYgc2hhcmVkIG1lbW9yeSBzZWdtZW50CiAgIyBjcmVhdGVfbW9kdWxlIC0gY3JlYXRlIGEgbG9hZGFibGUgbW9kdWxlIGVudHJ5CiAgIyBnZXR0aW1lb2ZkYXkgLSBnZXQgLyBzZXQgdGltZQogICMgdGltZXJfY3JlYXRlIC0gY3JlYXRlIGEgUE9TSVggcGVyLXByb2Nlc3MgdGltZXIKICAjIGZhbm90aWZ5X21hcmsgLSBhZGQsIHJlbW92ZSwgb3IgbW9kaWZ5IGFuIGZhbm90aWZ5IG1hcmsgb24gYSBmaWxlc3lzdGVtIG9iamVjdAogICMgcGlwZSAtIGNyZWF0ZSBwaXBlCiAgIyBwaXBlMiAtIGNyZWF0ZSBwaXBlCiAgIyBpbnRybyAtIGludHJvZHVjdGlvbiB0byBzeXN0ZW0gY2FsbHMKICAjIGxvb2t1cF9kY29va2llIC0gcmV0dXJuIGEgZGlyZWN0b3J5IGVudHJ5J3MgcGF0aAogICMgc3lzZnMgLSBnZXQgZmlsZXN5c3RlbSB0eXBlIGluZm9ybWF0aW9uCiAgIyBzMzkwX3J1bnRpbWVfaW5zdHIgLSBlbmFibGUvZGlzYWJsZSBzMzkwIENQVSBydW4tdGltZSBpbnN0cnVtZW50YXRpb24KICAjIHNldG5zIC0gcmVhc3NvY2lhdGUgdGhyZWFkIHdpdGggYSBuYW1lc3BhY2UKICAjIHNldF9tZW1wb2xpY3kgLSBzZXQgZGVmYXVsdCBOVU1BIG1lbW9yeSBwb2xpY3kgZm9yIGEgdGhyZWFkIGFuZCBpdHMgY2hpbGRyZW4KICAjIG5pY2UgLSBjaGFuZ2UgcHJvY2VzcyBwcmlvcml0eQogICMgaW9fc2V0dXAgLSBjcmVhdGUgYW4gYXN5bmNocm9ub3VzIEkvTyBjb250ZXh0CiAgIyBtaW5jb3JlIC0gZGV0ZXJtaW5lIHdoZXRoZXIgcGFnZXMgYXJlIHJlc2lkZW50IGluIG1lbW9yeQogICMgaW9jdGxfY29uc29sZSAtIGlvY3RscyBmb3IgY29uc29sZSB0ZXJtaW5hbCBhbmQgdmlydHVhbCBjb25zb2xlcwogICMgcG9sbCAtIHdhaXQgZm9yIHNvbWUgZXZlbnQgb24gYSBmaWxlIGRlc2NyaXB0b3IKICAjIHBwb2xsIC0gd2FpdCBmb3Igc29tZSBldmVudCBvbiBhIGZpbGUgZGVzY3JpcHRvcgogICMgY2FwZ2V0IC0gc2V0L2dldCBjYXBhYmlsaXRpZXMgb2YgdGhyZWFkKHMpCiAgIyBjYXBzZXQgLSBzZXQvZ2V0IGNhcGFiaWxpdGllcyBvZiB0aHJlYWQocykKICAjIGlvY3RsX3R0eSAtIGlvY3RscyBmb3IgdGVybWluYWxzIGFuZCBzZXJpYWwgbGluZXMKICAjIGlvcGwgLSBjaGFuZ2UgSS9PIHByaXZpbGVnZSBsZXZlbAogICMgaW9fZ2V0ZXZlbnRzIC0gcmVhZCBhc3luY2hyb25vdXMgSS9PIGV2ZW50cyBmcm9tIHRoZSBjb21wbGV0aW9uIHF1ZXVlCiAgIyBwcm9jZXNzX3ZtX3JlYWR2IC0gdHJhbnNmZXIgZGF0YSBiZXR3ZWVuIHByb2Nlc3MgYWRkcmVzcyBzcGFjZXMKICAjIHByb2Nlc3Nfdm1fd3JpdGV2IC0gdHJhbnNmZXIgZGF0YSBiZXR3ZWVuIHByb2Nlc3MgYWRkcmVzcyBzcGFjZXMKICAjIHBjaWNvbmZpZ19yZWFkIC0gcGNpIGRldmljZSBpbmZvcm1hdGlvbiBoYW5kbGluZwogICMgcGNpY29uZmlnX3dyaXRlIC0gcGNpIGRldmljZSBpbmZvcm1hdGlvbiBoYW5kbGluZwogICMgcGNpY29uZmlnX2lvYmFzZSAtIHBjaSBkZXZpY2UgaW5mb3JtYXRpb24gaGFuZGxpbmcKICAjIHNicmsgLSBjaGFuZ2UgZGF0YSBzZWdtZW50IHNpemUKICAjIHNldF90aWRfYWRkcmVzcyAtIHNldCBwb2ludGVyIHRvIHRocmVhZCBJRAogICMgcGtleV9hbGxvYyAtIGFsbG9jYXRlIG9yIGZyZWUgYSBwcm90ZWN0aW9uIGtleQogICMgcGtleV9mcmVlIC0gYWxsb2NhdGUgb3IgZnJlZSBhIHByb3RlY3Rpb24ga2V5CiAgIyBzZWxlY3QgLSBzeW5jaHJvbm91cyBJL08gbXVsdGlwbGV4aW5nCiAgIyBwc2VsZWN0IC0gc3luY2hyb25vdXMgSS9PIG11bHRpcGxleGluZwogICMga2V4ZWNfbG9hZCAtIGxvYWQgYSBuZXcga2VybmVsIGZvciBsYXRlciBleGVjdXRpb24KICAjIGtleGVjX2ZpbGVfbG9hZCAtIGxvYWQgYSBuZXcga2VybmVsIGZvciBsYXRlciBleGVjdXRpb24KICAjIHBlcnNvbmFsaXR5IC0gc2V0IHRoZSBwcm9jZXNzIGV4ZWN1dGlvbiBkb21haW4KICAjIGlvY3RsX2dldGZzbWFwIC0gcmV0cmlldmUgdGhlIHBoeXNpY2FsIGxheW91dCBvZiB0aGUgZmlsZXN5c3RlbQogICMgaW5vdGlmeV9ybV93YXRjaCAtIHJlbW92ZSBhbiBleGlzdGluZyB3YXRjaCBmcm9tIGFuIGlub3RpZnkgaW5zdGFuY2UKICAjIHBlcmZfZXZlbnRfb3BlbiAtIHNldCB1cCBwZXJmb3JtYW5jZSBtb25pdG9yaW5nCiAgIyB0aW1lcl9nZXRvdmVycnVuIC0gZ2V0IG92ZXJydW4gY291bnQgZm9yIGEgUE9TSVggcGVyLXByb2Nlc3MgdGltZXIKICAjIHdhaXQzIC0gd2FpdCBmb3IgcHJvY2VzcyB0byBjaGFuZ2Ugc3RhdGUsIEJTRCBzdHlsZQogICMgd2FpdDQgLSB3YWl0IGZvciBwcm9jZXNzIHRvIGNoYW5nZSBzdGF0ZSwgQlNEIHN0eWxlCiAgIyBsaW5rIC0gbWFrZSBhIG5ldyBuYW1lIGZvciBhIGZpbGUKICAjIGxpbmthdCAtIG1ha2UgYSBuZXcgbmFtZSBmb3IgYSBmaWxlCiAgIyBzY2hlZF9ycl9nZXRfaW50ZXJ2YWwgLSBnZXQgdGhlIFNDSEVEX1JSIGludGVydmFsIGZvciB0aGUgbmFtZWQgcHJvY2VzcwogICMgbXVubWFwIC0gbWFwIG9yIHVubWFwIGZpbGVzIG9yIGRldmljZXMgaW50byBtZW1vcnkKICAjIHNvY2tldGNhbGwgLSBzb2NrZXQgc3lzdGVtIGNhbGxzCiAgIyBtcmVtYXAgLSByZW1hcCBhIHZpcnR1YWwgbWVtb3J5IGFkZHJlc3MKICAjIHZmb3JrIC0gY3JlYXRlIGEgY2hpbGQgcHJvY2VzcyBhbmQgYmxvY2sgcGFyZW50CiAgIyBzZWNjb21wIC0gb3BlcmF0ZSBvbiBTZWN1cmUgQ29tcHV0aW5nIHN0YXRlIG9mIHRoZSBwcm9jZXNzCiAgIyByZXF1ZXN0X2tleSAtIHJlcXVlc3QgYSBrZXkgZnJvbSB0aGUga2VybmVsJ3Mga2V5IG1hbmFnZW1lbnQgZmFjaWxpdHkKICAjIHN5c2NhbGwgLSBpbmRpcmVjdCBzeXN0ZW0gY2FsbAogICMga2NtcCAtIGNvbXBhcmUgdHdvIHByb2Nlc3NlcyB0byBkZXRlcm1pbmUgaWYgdGhleSBzaGFyZSBhIGtlcm5lbCByZXNvdXJjZQogICMgcmVhZGRpciAtIHJlYWQgZGlyZWN0b3J5IGVudHJ5CiAgIyBpb3Blcm0gLSBzZXQgcG9ydCBpbnB1dC9vdXRwdXQgcGVybWlzc2lvbnMKICAjIHNwdV9ydW4gLSBleGVjdXRlIGFuIFNQVSBjb250ZXh0CiAgIyByZWJvb3QgLSByZWJvb3Qgb3IgZW5hYmxlL2Rpc2FibGUgQ3RybC1BbHQtRGVsCiAgIyByZWFkYWhlYWQgLSBpbml0aWF0ZSBmaWxlIHJlYWRhaGVhZCBpbnRvIHBhZ2UgY2FjaGUKICAjIHNjaGVkX2dldHBhcmFtIC0gc2V0IGFuZCBnZXQgc2NoZWR1bGluZyBwYXJhbWV0ZXJzCiAgIyBhY2N0IC0gc3dpdGNoIHByb2Nlc3MgYWNjb3VudGluZyBvbiBvciBvZmYKICAjIHNpZ3N1c3BlbmQgLSB3YWl0IGZvciBhIHNpZ25hbAogICMgcnRfc2lnc3VzcGVuZCAtIHdhaXQgZm9yIGEgc2lnbmFsCiAgIyBleGl0X2dyb3VwIC0gZXhpdCBhbGwgdGhyZWFkcyBpbiBhIHByb2Nlc3MKICAjIHNvY2tldCAtIGNyZWF0ZSBhbiBlbmRwb2ludCBmb3IgY29tbXVuaWNhdGlvbgogICMgaW9jdGxfdXNlcmZhdWx0ZmQgLSBjcmVhdGUgYSBmaWxlIGRlc2NyaXB0b3IgZm9yIGhhbmRsaW5nIHBhZ2UgZmF1bHRzIGluIHVzZXIgc3BhY2UKICAjIHNjaGVkX2dldF9wcmlvcml0eV9tYXggLSBnZXQgc3RhdGljIHByaW9yaXR5IHJhbmdlCiAgIyBzY2hlZF9nZXRfcHJpb3JpdHlfbWluICAtIGdldCBzdGF0aWMgcHJpb3JpdHkgcmFuZ2UKICAjIGdldGRlbnRzIC0gZ2V0IGRpcmVjdG9yeSBlbnRyaWVzCiAgIyBnZXRkZW50czY0IC0gZ2V0IGRpcmVjdG9yeSBlbnRyaWVzCiAgIyBzZWxlY3QgLSBzeW5jaHJvbm91cyBJL08gbXVsdGlwbGV4aW5nCiAgIyBwc2VsZWN0IC0gc3luY2hyb25vdXMgSS9PIG11bHRpcGxleGluZwogICMgYWxsb2NfaHVnZXBhZ2VzIC0gYWxsb2NhdGUgb3IgZnJlZSBodWdlIHBhZ2VzCiAgIyBmcmVlX2h1Z2VwYWdlcyAtIGFsbG9jYXRlIG9yIGZyZWUgaHVnZSBwYWdlcwogICMgZnN5bmMgLSBzeW5jaHJvbml6ZSBhIGZpbGUncyBpbi1jb3JlIHN0YXRlIHdpdGggc3RvcmFnZSBkZXZpY2UKICAjIGZkYXRhc3luYyAtIHN5bmNocm9uaXplIGEgZmlsZSdzIGluLWNvcmUgc3RhdGUgd2l0aCBzdG9yYWdlIGRldmljZQogICMgc3lzY2FsbHMgLSBMaW51eCBzeXN0ZW0gY2FsbHMKICAjIG1lbWZkX2NyZWF0ZSAtIGNyZWF0ZSBhbiBhbm9ueW1vdXMgZmlsZQogICMgbW9kaWZ5X2xkdCAtIGdldCBvciBzZXQgYSBwZXItcHJvY2VzcyBMRFQgZW50cnkKICAjIGdldF9rZXJuZWxfc3ltcyAtIHJldHJpZXZlIGV4cG9ydGVkIGtlcm5lbCBhbmQgbW9kdWxlIHN5bWJvbHMKICAjIGxzZWVrIC0gcmVwb3NpdGlvbiByZWFkL3dyaXRlIGZpbGUgb2Zmc2V0CiAgIyBzaG1hdCAtIFN5c3RlbSBWIHNoYXJlZCBtZW1vcnkgb3BlcmF0aW9ucwogICMgc2htZHQgLSBTeXN0ZW0gViBzaGFyZWQgbWVtb3J5IG9wZXJhdGlvbnMKICAjIHRpbWVyX2RlbGV0ZSAtIGRlbGV0ZSBhIFBPU0lYIHBlci1wcm9jZXNzIHRpbWVyCiAgIyBwZXJmbW9uY3RsIC0gaW50ZXJmYWNlIHRvIElBLTY0IHBlcmZvcm1hbmNlIG1vbml0b3JpbmcgdW5pdAogICMgbW92ZV9wYWdlcyAtIG1vdmUgaW5kaXZpZHVhbCBwYWdlcyBvZiBhIHByb2Nlc3MgdG8gYW5vdGhlciBub2RlCiAgIyBjaGRpciAtIGNoYW5nZSB3b3JraW5nIGRpcmVjdG9yeQogICMgZmNoZGlyIC0gY2hhbmdlIHdvcmtpbmcgZGlyZWN0b3J5CiAgIyB0aW1lIC0gZ2V0IHRpbWUgaW4gc2Vjb25kcwogICMgX2V4aXQgLSB0ZXJtaW5hdGUgdGhlIGNhbGxpbmcgcHJvY2VzcwogICMgX0V4aXQgLSB0ZXJtaW5hdGUgdGhlIGNhbGxpbmcgcHJvY2VzcwogICMgczM5MF9zdGh5aSAtIGVtdWxhdGUgU1RIWUkgaW5zdHJ1Y3Rpb24KICAjIGlvX3N1Ym1pdCAtIHN1Ym1pdCBhc3luY2hyb25vdXMgSS9PIGJsb2NrcyBmb3IgcHJvY2Vzc2luZwogICMgbWxvY2sgLSBsb2NrIGFuZCB1bmxvY2sgbWVtb3J5CiAgIyBtbG9jazIgLSBsb2NrIGFuZCB1bmxvY2sgbWVtb3J5CiAgIyBtdW5sb2NrIC0gbG9jayBhbmQgdW5sb2NrIG1lbW9yeQogICMgbWxvY2thbGwgLSBsb2NrIGFuZCB1bmxvY2sgbWVtb3J5CiAgIyBtdW5sb2NrYWxsIC0gbG9jayBhbmQgdW5sb2NrIG1lbW9yeQogICMgdW1hc2sgLSBzZXQgZmlsZSBtb2RlIGNyZWF0aW9uIG1hc2sKICAjIGFyY2hfcHJjdGwgLSBzZXQgYXJjaGl0ZWN0dXJlLXNwZWNpZmljIHRocmVhZCBzdGF0ZQogICMgdXNlbGliIC0gbG9hZCBzaGFyZWQgbGlicmFyeQogICMgc2VuZGZpbGUgLSB0cmFuc2ZlciBkYXRhIGJldHdlZW4gZmlsZSBkZXNjcmlwdG9ycwogICMgc2htY3RsIC0gU3lzdGVtIFYgc2hhcmVkIG1lbW9yeSBjb250cm9sCiAgIyBlcG9sbF93YWl0IC0gd2FpdCBmb3IgYW4gSS9PIGV2ZW50IG9uIGFuIGVwb2xsIGZpbGUgZGVzY3JpcHRvcgogICMgZXBvbGxfcHdhaXQgLSB3YWl0IGZvciBhbiBJL08gZXZlbnQgb24gYW4gZXBvbGwgZmlsZSBkZXNjcmlwdG9yCiAgIyBzaWdhbHRzdGFjayAtIHNldCBhbmQvb3IgZ2V0IHNpZ25hbCBzdGFjayBjb250ZXh0CiAgIyBpb2N0bCAtIGNvbnRyb2wgZGV2aWNlCiAgIyBzaWduYWxmZCAtIGNyZWF0ZSBhIGZpbGUgZGVzY3JpcHRvciBmb3IgYWNjZXB0aW5nIHNpZ25hbHMKICAjIHVuc2hhcmUgLSBkaXNhc3NvY2lhdGUgcGFydHMgb2YgdGhlIHByb2Nlc3MgZXhlY3V0aW9uIGNvbnRleHQKICAjIGNocm9vdCAtIGNoYW5nZSByb290IGRpcmVjdG9yeQogICMgbWFkdmlzZSAtIGdpdmUgYWR2aWNlIGFib3V0IHVzZSBvZiBtZW1vcnkKICAjIGdldHRpZCAtIGdldCB0aHJlYWQgaWRlbnRpZmljYXRpb24KICAjIGdldHNvY2tuYW1lIC0gZ2V0IHNvY2tldCBuYW1lCiAgIyBpb19kZXN0cm95IC0gZGVzdHJveSBhbiBhc3luY2hyb25vdXMgSS9PIGNvbnRleHQKICAjIHNldHVwIC0gc2V0dXAgZGV2aWNlcyBhbmQgZmlsZXN5c3RlbXMsIG1vdW50IHJvb3QgZmlsZXN5c3RlbQogICMgbnRwX2FkanRpbWUgLSB0dW5lIGtlcm5lbCBjbG9jawogICMgc2VtZ2V0IC0gZ2V0IGEgU3lzdGVtIFYgc2VtYXBob3JlIHNldCBpZGVudGlmaWVyCiAgIyBnZXR1aWQgLSBnZXQgdXNlciBpZGVudGl0eQogICMgZ2V0ZXVpZCAtIGdldCB1c2VyIGlkZW50aXR5CiAgIyBfc3lzY2FsbCAtIGludm9raW5nIGEgc3lzdGVtIGNhbGwgd2l0aG91dCBsaWJyYXJ5IHN1cHBvcnQgKE9CU09MRVRFKQogICMgc2NoZWRfc2V0YWZmaW5pdHkgLSBcIHNldCBhbmQgZ2V0IGEgdGhyZWFkJ3MgQ1BVIGFmZmluaXR5IG1hc2sKICAjIHNjaGVkX2dldGFmZmluaXR5IC0gXCBzZXQgYW5kIGdldCBhIHRocmVhZCdzIENQVSBhZmZpbml0eSBtYXNrCiAgIyBjYWNoZWZsdXNoIC0gZmx1c2ggY29udGVudHMgb2YgaW5zdHJ1Y3Rpb24gYW5kL29yIGRhdGEgY2FjaGUKICAjIHBpdm90X3Jvb3QgLSBjaGFuZ2UgdGhlIHJvb3QgbW91bnQKICAjIG1zZ2N0bCAtIFN5c3RlbSBWIG1lc3NhZ2UgY29udHJvbCBvcGVyYXRpb25zCiAgIyBpb2N0bF9mYXQgLSBtYW5pcHVsYXRpbmcgdGhlIEZBVCBmaWxlc3lzdGVtCiAgIyBzZXRwZ2lkIC0gc2V0L2dldCBwcm9jZXNzIGdyb3VwCiAgIyBnZXRwZ2lkIC0gc2V0L2dldCBwcm9jZXNzIGdyb3VwCiAgIyBzZXRwZ3JwIC0gc2V0L2dldCBwcm9jZXNzIGdyb3VwCiAgIyBnZXRwZ3JwIC0gc2V0L2dldCBwcm9jZXNzIGdyb3VwCiAgIyBzb2NrZXRwYWlyIC0gY3JlYXRlIGEgcGFpciBvZiBjb25uZWN0ZWQgc29ja2V0cwogICMgYmRmbHVzaCAtIHN0YXJ0LCBmbHVzaCwgb3IgdHVuZSBidWZmZXItZGlydHktZmx1c2ggZGFlbW9uCiAgIyBhbGFybSAtIHNldCBhbiBhbGFybSBjbG9jayBmb3IgZGVsaXZlcnkgb2YgYSBzaWduYWwKICAjIHRpbWVyX3NldHRpbWUgLSBhcm0vZGlzYXJtIGFuZCBmZXRjaCBzdGF0ZSBvZiBQT1NJWCBwZXItcHJvY2VzcyB0aW1lcgogICMgdGltZXJfZ2V0dGltZSAtIGFybS9kaXNhcm0gYW5kIGZldGNoIHN0YXRlIG9mIFBPU0lYIHBlci1wcm9jZXNzIHRpbWVyCiAgIyBhZGRfa2V5IC0gYWRkIGEga2V5IHRvIHRoZSBrZXJuZWwncyBrZXkgbWFuYWdlbWVudCBmYWNpbGl0eQogICMgcnRfc2lncXVldWVpbmZvIC0gcXVldWUgYSBzaWduYWwgYW5kIGRhdGEKICAjIHJ0X3Rnc2lncXVldWVpbmZvIC0gcXVldWUgYSBzaWduYWwgYW5kIGRhdGEKICAjIHVzZXJmYXVsdGZkIC0gY3JlYXRlIGEgZmlsZSBkZXNjcmlwdG9yIGZvciBoYW5kbGluZyBwYWdlIGZhdWx0cyBpbiB1c2VyIHNwYWNlCiAgIyBzZW1vcCAtIFN5c3RlbSBWIHNlbWFwaG9yZSBvcGVyYXRpb25zCiAgIyBzZW10aW1lZG9wIC0gU3lzdGVtIFYgc2VtYXBob3JlIG9wZXJhdGlvbnMKICAjIGdldGdpZCAtIGdldCBncm91cCBpZGVudGl0eQogICMgZ2V0ZWdpZCAtIGdldCBncm91cCBpZGVudGl0eQogICMgZ2V0cGlkIC0gZ2V0IHByb2Nlc3MgaWRlbnRpZmljYXRpb24KICAjIGdldHBwaWQgLSBnZXQgcHJvY2VzcyBpZGVudGlmaWNhdGlvbgogICMgc2lncHJvY21hc2sgLSBleGFtaW5lIGFuZCBjaGFuZ2UgYmxvY2tlZCBzaWduYWxzCiAgIyBydF9zaWdwcm9jbWFzayAtIGV4YW1pbmUgYW5kIGNoYW5nZSBibG9ja2VkIHNpZ25hbHMKICAjIHVuYW1lIC0gZ2V0IG5hbWUgYW5kIGluZm9ybWF0aW9uIGFib3V0IGN1cnJlbnQga2VybmVsCiAgIyBzdGF0eCAtIGdldCBmaWxlIHN0YXR1cyAoZXh0ZW5kZWQpCiAgIyBpb2N0bF9maWNsb25lcmFuZ2UgLSBzaGFyZSBzb21lIHRoZSBkYXRhIG9mIG9uZSBmaWxlIHdpdGggYW5vdGhlciBmaWxlCiAgIyBpb2N0bF9maWNsb25lIC0gc2hhcmUgc29tZSB0aGUgZGF0YSBvZiBvbmUgZmlsZSB3aXRoIGFub3RoZXIgZmlsZQogICMgbXFfZ2V0c2V0YXR0ciAtIGdldC9zZXQgbWVzc2FnZSBxdWV1ZSBhdHRyaWJ1dGVzCiAgIyBpb3ByaW9fZ2V0IC0gZ2V0L3NldCBJL08gc2NoZWR1bGluZyBjbGFzcyBhbmQgcHJpb3JpdHkKICAjIGlvcHJpb19zZXQgLSBnZXQvc2V0IEkvTyBzY2hlZHVsaW5nIGNsYXNzIGFuZCBwcmlvcml0eQogICMgZXBvbGxfY3RsIC0gY29udHJvbCBpbnRlcmZhY2UgZm9yIGFuIGVwb2xsIGZpbGUgZGVzY3JpcHRvcgogICMgc3luYyAtIGNvbW1pdCBmaWxlc3lzdGVtIGNhY2hlcyB0byBkaXNrCiAgIyBzeW5jZnMgLSBjb21taXQgZmlsZXN5c3RlbSBjYWNoZXMgdG8gZGlzawogICMgc2V0c2lkIC0gY3JlYXRlcyBhIHNlc3Npb24gYW5kIHNldHMgdGhlIHByb2Nlc3MgZ3JvdXAgSUQKICAjIHNodXRkb3duIC0gc2h1dCBkb3duIHBhcnQgb2YgYSBmdWxsLWR1cGxleCBjb25uZWN0aW9uCiAgIyBnZXRzaWQgLSBnZXQgc2Vzc2lvbiBJRAogICMgZ2V0X3RocmVhZF9hcmVhIC0gbWFuaXB1bGF0ZSB0aHJlYWQtbG9jYWwgc3RvcmFnZSBpbmZvcm1hdGlvbgogICMgc2V0X3RocmVhZF9hcmVhIC0gbWFuaXB1bGF0ZSB0aHJlYWQtbG9jYWwgc3RvcmFnZSBpbmZvcm1hdGlvbgogICMgdGltZXJmZF9jcmVhdGUgLSB0aW1lcnMgdGhhdCBub3RpZnkgdmlhIGZpbGUgZGVzY3JpcHRvcnMKICAjIHRpbWVyZmRfc2V0dGltZSAtIHRpbWVycyB0aGF0IG5vdGlmeSB2aWEgZmlsZSBkZXNjcmlwdG9ycwogICMgdGltZXJmZF9nZXR0aW1lIC0gdGltZXJzIHRoYXQgbm90aWZ5IHZpYSBmaWxlIGRlc2NyaXB0b3JzCiAgIyBpb2N0bF9maWRlZHVwZXJhbmdlIC0gc2hhcmUgc29tZSB0aGUgZGF0YSBvZiBvbmUgZmlsZSB3aXRoIGFub3RoZXIgZmlsZQogICMgbmFtZV90b19oYW5kbGVfYXQgLSBvYnRhaW4gaGFuZGxlIGZvciBhIHBhdGhuYW1lIGFuZCBvcGVuIGZpbGUgdmlhIGEgaGFuZGxlCiAgIyBvcGVuX2J5X2hhbmRsZV9hdCAtIG9idGFpbiBoYW5kbGUgZm9yIGEgcGF0aG5hbWUgYW5kIG9wZW4gZmlsZSB2aWEgYSBoYW5kbGUKICAjIGZ1dGV4IC0gZmFzdCB1c2VyLXNwYWNlIGxvY2tpbmcKICAjIGlvY3RsX25zIC0gaW9jdGwoKSBvcGVyYXRpb25zIGZvciBMaW51eCBuYW1lc3BhY2VzCiAgIyBwaWRmZF9zZW5kX3NpZ25hbCAtIHNlbmQgYSBzaWduYWwgdG8gYSBwcm9jZXNzIHNwZWNpZmllZCBieSBhIGZpbGUgZGVzY3JpcHRvcgogICMgY2xvbmUgLSBjcmVhdGUgYSBjaGlsZCBwcm9jZXNzCiAgIyBfX2Nsb25lMiAtIGNyZWF0ZSBhIGNoaWxkIHByb2Nlc3MKICAjIGNsb25lMyAtIGNyZWF0ZSBhIGNoaWxkIHByb2Nlc3MKICAjIHRpbWVzIC0gZ2V0IHByb2Nlc3MgdGltZXMKICAjIHN5c2luZm8gLSByZXR1cm4gc3lzdGVtIGluZm9ybWF0aW9uCiAgIyBpcGMgLSBTeXN0ZW0gViBJUEMgc3lzdGVtIGNhbGxzCiAgIyBldmVudGZkIC0gY3JlYXRlIGEgZmlsZSBkZXNjcmlwdG9yIGZvciBldmVudCBub3RpZmljYXRpb24KICAjIHdhaXQgLSB3YWl0IGZvciBwcm9jZXNzIHRvIGNoYW5nZSBzdGF0ZQogICMgd2FpdHBpZCAtIHdhaXQgZm9yIHByb2Nlc3MgdG8gY2hhbmdlIHN0YXRlCiAgIyB3YWl0aWQgLSB3YWl0IGZvciBwcm9jZXNzIHRvIGNoYW5nZSBzdGF0ZQogICMgZ2V0ZG9tYWlubmFtZSAtIGdldC9zZXQgTklTIGRvbWFpbiBuYW1lCiAgIyBpZGxlIC0gbWFrZSBwcm9jZXNzIDAgaWRsZQogICMgaW5vdGlmeV9hZGRfd2F0Y2ggLSBhZGQgYSB3YXRjaCB0byBhbiBpbml0aWFsaXplZCBpbm90aWZ5IGluc3RhbmNlCiAgIyBnZXRfbWVtcG9saWN5IC0gcmV0cmlldmUgTlVNQSBtZW1vcnkgcG9saWN5IGZvciBhIHRocmVhZAogICMgYnBmIC0gcGVyZm9ybSBhIGNvbW1hbmQgb24gYW4gZXh0ZW5kZWQgQlBGIG1hcCBvciBwcm9ncmFtCiAgIyBnZXRzb2Nrb3B0IC0gZ2V0IGFuZCBzZXQgb3B0aW9ucyBvbiBzb2NrZXRzCiAgIyBzZXRzb2Nrb3B0IC0gZ2V0IGFuZCBzZXQgb3B0aW9ucyBvbiBzb2NrZXRzCiAgIyBnZXRpdGltZXIgLSBnZXQgb3Igc2V0IHZhbHVlIG9mIGFuIGludGVydmFsIHRpbWVyCiAgIyBzZXRpdGltZXIgLSBnZXQgb3Igc2V0IHZhbHVlIG9mIGFuIGludGVydmFsIHRpbWVyCiAgIyBtZW1iYXJyaWVyIC0gaXNzdWUgbWVtb3J5IGJhcnJpZXJzIG9uIGEgc2V0IG9mIHRocmVhZHMKICAjIG1wcm90ZWN0IC0gc2V0IHByb3RlY3Rpb24gb24gYSByZWdpb24gb2YgbWVtb3J5CiAgIyBwa2V5X21wcm90ZWN0IC0gc2V0IHByb3RlY3Rpb24gb24gYSByZWdpb24gb2YgbWVtb3J5CiAgIyBnZXRjcHUgLSBkZXRlcm1pbmUgQ1BVIGFuZCBOVU1BIG5vZGUgb24gd2hpY2ggdGhlIGNhbGxpbmcgdGhyZWFkIGlzIHJ1bm5pbmcKICAjIGlvY3RsX2ZzbGFiZWwgLSBnZXQgb3Igc2V0IGEgZmlsZXN5c3RlbSBsYWJlbAogICMgbGlzdHhhdHRyIC0gbGlzdCBleHRlbmRlZCBhdHRyaWJ1dGUgbmFtZXMKICAjIGxsaXN0eGF0dHIgLSBsaXN0IGV4dGVuZGVkIGF0dHJpYnV0ZSBuYW1lcwogICMgZmxpc3R4YXR0ciAtIGxpc3QgZXh0ZW5kZWQgYXR0cmlidXRlIG5hbWVzCiAgIyBfbGxzZWVrIC0gcmVwb3NpdGlvbiByZWFkL3dyaXRlIGZpbGUgb2Zmc2V0CiAgIyBmY250bCAtIG1hbmlwdWxhdGUgZmlsZSBkZXNjcmlwdG9yCiAgIyB1c3RhdCAtIGdldCBmaWxlc3lzdGVtIHN0YXRpc3RpY3MKICAjIHNpZ25hbCAtIEFOU0kgQyBzaWduYWwgaGFuZGxpbmcKICAjIG5hbm9zbGVlcCAtIGhpZ2gtcmVzb2x1dGlvbiBzbGVlcAogICMgY29ubmVjdCAtIGluaXRpYXRlIGEgY29ubmVjdGlvbiBvbiBhIHNvY2tldAogICMgdm04Nm9sZCAtIGVudGVyIHZpcnR1YWwgODA4NiBtb2RlCiAgIyB2bTg2IC0gZW50ZXIgdmlydHVhbCA4MDg2IG1vZGUKICAjIGZhbm90aWZ5X2luaXQgLSBjcmVhdGUgYW5kIGluaXRpYWxpemUgZmFub3RpZnkgZ3JvdXAKICAjIG1pZ3JhdGVfcGFnZXMgLSBtb3ZlIGFsbCBwYWdlcyBpbiBhIHByb2Nlc3MgdG8gYW5vdGhlciBzZXQgb2Ygbm9kZXMKICAjIHJlc3RhcnRfc3lzY2FsbCAtIHJlc3RhcnQgYSBzeXN0ZW0gY2FsbCBhZnRlciBpbnRlcnJ1cHRpb24gYnkgYSBzdG9wIHNpZ25hbAogICMgY2xvc2UgLSBjbG9zZSBhIGZpbGUgZGVzY3JpcHRvcgogICMgbXNncmN2IC0gU3lzdGVtIFYgbWVzc2FnZSBxdWV1ZSBvcGVyYXRpb25zCiAgIyBtc2dzbmQgLSBTeXN0ZW0gViBtZXNzYWdlIHF1ZXVlIG9wZXJhdGlvbnMKICAjIHJlYWR2IC0gcmVhZCBvciB3cml0ZSBkYXRhIGludG8gbXVsdGlwbGUgYnVmZmVycwogICMgd3JpdGV2IC0gcmVhZCBvciB3cml0ZSBkYXRhIGludG8gbXVsdGlwbGUgYnVmZmVycwogICMgcHJlYWR2IC0gcmVhZCBvciB3cml0ZSBkYXRhIGludG8gbXVsdGlwbGUgYnVmZmVycwogICMgcHdyaXRldiAtIHJlYWQgb3Igd3JpdGUgZGF0YSBpbnRvIG11bHRpcGxlIGJ1ZmZlcnMKICAjIHByZWFkdjIgLSByZWFkIG9yIHdyaXRlIGRhdGEgaW50byBtdWx0aXBsZSBidWZmZXJzCiAgIyBwd3JpdGV2MiAtIHJlYWQgb3Igd3JpdGUgZGF0YSBpbnRvIG11bHRpcGxlIGJ1ZmZlcnMKICAjIHN5c2xvZyAtIHJlYWQgYW5kL29yIGNsZWFyIGtlcm5lbCBtZXNzYWdlIHJpbmcgYnVmZmVyOyBzZXQgY29uc29sZV9sb2dsZXZlbAogICMga2xvZ2N0bCAtIHJlYWQgYW5kL29yIGNsZWFyIGtlcm5lbCBtZXNzYWdlIHJpbmcgYnVmZmVyOyBzZXQgY29uc29sZV9sb2dsZXZlbAogICMgc2NoZWRfeWllbGQgLSB5aWVsZCB0aGUgcHJvY2Vzc29yCiAgIyB2aGFuZ3VwIC0gdmlydHVhbGx5IGhhbmd1cCB0aGUgY3VycmVudCB0ZXJtaW5hbAogICMgaW9jdGxfaWZsYWdzIC0gaW9jdGwoKSBvcGVyYXRpb25zIGZvciBpbm9kZSBmbGFncwogICMgc2dldG1hc2sgLSBtYW5pcHVsYXRpb24gb2Ygc2lnbmFsIG1hc2sgKG9ic29sZXRlKQogICMgc3NldG1hc2sgLSBtYW5pcHVsYXRpb24gb2Ygc2lnbmFsIG1hc2sgKG9ic29sZXRlKQogICMgc3luY19maWxlX3JhbmdlIC0gc3luYyBhIGZpbGUgc2VnbWVudCB3aXRoIGRpc2sKICAjIGNvcHlfZmlsZV9yYW5nZSAtIENvcHkgYSByYW5nZSBvZiBkYXRhIGZyb20gb25lIGZpbGUgdG8gYW5vdGhlcgogICMgc2lncGVuZGluZyAtIGV4YW1pbmUgcGVuZGluZyBzaWduYWxzCiAgIyBydF9zaWdwZW5kaW5nIC0gZXhhbWluZSBwZW5kaW5nIHNpZ25hbHMKICAjIGdldHVud2luZCAtIGNvcHkgdGhlIHVud2luZCBkYXRhIHRvIGNhbGxlcidzIGJ1ZmZlcgogICMgbXN5bmMgLSBzeW5jaHJvbml6ZSBhIGZpbGUgd2l0aCBhIG1lbW9yeSBtYXAKICAjIGdldF9yb2J1c3RfbGlzdCAtIGdldC9zZXQgbGlzdCBvZiByb2J1c3QgZnV0ZXhlcwogICMgc2V0X3JvYnVzdF9saXN0IC0gZ2V0L3NldCBsaXN0IG9mIHJvYnVzdCBmdXRleGVzCiAgIyBkdXAgLSBkdXBsaWNhdGUgYSBmaWxlIGRlc2NyaXB0b3IKICAjIGR1cDIgLSBkdXBsaWNhdGUgYSBmaWxlIGRlc2NyaXB0b3IKICAjIGR1cDMgLSBkdXBsaWNhdGUgYSBmaWxlIGRlc2NyaXB0b3IKICAjIHMzOTBfcGNpX21taW9fd3JpdGUgLSB0cmFuc2ZlciBkYXRhIHRvL2Zyb20gUENJIE1NSU8gbWVtb3J5IHBhZ2UKICAjIHMzOTBfcGNpX21taW9fcmVhZCAtIHRyYW5zZmVyIGRhdGEgdG8vZnJvbSBQQ0kgTU1JTyBtZW1vcnkgcGFnZQogICMgcGF1c2UgLSB3YWl0IGZvciBzaWduYWwKICAjIHN3YXBvbiAtIHN0YXJ0L3N0b3Agc3dhcHBpbmcgdG8gZmlsZS9kZXZpY2UKICAjIHN3YXBvZmYgLSBzdGFydC9zdG9wIHN3YXBwaW5nIHRvIGZpbGUvZGV2aWNlCgogICMgUmVjb3JkIHR5cGUgbm9ybWFsaXphdGlvbnMKICAjIFVzZWZ1bCBsaW5rczoKICAjIGh0dHBzOi8vcmF3LmdpdGh1YnVzZXJjb250ZW50LmNvbS90b3J2YWxkcy9saW51eC92NC4xNi9pbmNsdWRlL3VhcGkvbGludXgvYXVkaXQuaAogICMgaHR0cHM6Ly9yYXcuZ2l0aHVidXNlcmNvbnRlbnQuY29tL2xpbnV4LWF1ZGl0L2F1ZGl0LXVzZXJzcGFjZS80ZDkzMzMwMWIxODM1Y2FmYTA4YjllOWVmNzA1YzhmYjZjOTZjYjYyL2xpYi9saWJhdWRpdC5oCiAgIyBodHRwczovL3d3dy5lbGFzdGljLmNvL2d1aWRlL2VuL2Vjcy9jdXJyZW50L2Vjcy1hbGxvd2VkLXZhbHVlcy1ldmVudC1jYXRlZ29yeS5odG1sCgogICMgSUFNIHJlbGF0ZWQgZXZlbnRzCgogICMgQVVESVRfQUNDVF9MT0NLIC0gVXNlcidzIGFjY291bnQgbG9ja2VkIGJ5IGFkbWluCiAgLSByZWNvcmRfdHlwZXM6IEFDQ1RfTE9DSwogICAgYWN0aW9uOiBsb2NrZWQtYWNjb3VudAogICAgZWNzOgogICAgICA8PDogKmVjcy1pYW0KICAgICAgdHlwZToKICAgICAgICAtIHVzZXIKICAgICAgICAtIGluZm8KICAjIEFVRElUX0FDQ1RfVU5MT0NLIC0gVXNlcidzIGFjY291bnQgdW5sb2NrZWQgYnkgYWRtaW4KICAtIHJlY29yZF90eXBlczogQUNDVF9VTkxPQ0sKICAgIGFjdGlvbjogdW5sb2NrZWQtYWNjb3VudAogICAgZWNzOgogICAgICA8PDogKmVjcy1pYW0KICAgICAgdHlwZToKICAgICAgICAtIHVzZXIKICAgICAgICAtIGluZm8KICAjIEFVRElUX0FERF9HUk9VUCAtIEdyb3VwIGFjY291bnQgYWRkZWQKICAtIHJlY29yZF90eXBlczogQUREX0dST1VQCiAgICBhY3Rpb246IGFkZGVkLWdyb3VwLWFjY291bnQtdG8KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogW2lkLCBhY2N0XQogICAgICB3aGF0OiBhY2NvdW50CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWlhbQogICAgICA8PDogKmVjcy1ncm91cC1tb2RpZmljYXRpb24tbWFwcGluZ3MKICAgICAgdHlwZToKICAgICAgICAtIGdyb3VwCiAgICAgICAgLSBjcmVhdGlvbgoKICAjIEFVRElUX0FERF9VU0VSIC0gVXNlciBhY2NvdW50IGFkZGVkCiAgLSByZWNvcmRfdHlwZXM6IEFERF9VU0VSCiAgICBhY3Rpb246IGFkZGVkLXVzZXItYWNjb3VudAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbaWQsIGFjY3RdCiAgICAgIHdoYXQ6IGFjY291bnQKICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIDw8OiAqZWNzLXVzZXItbW9kaWZpY2F0aW9uLW1hcHBpbmdzCiAgICAgIHR5cGU6CiAgICAgICAgLSB1c2VyCiAgICAgICAgLSBjcmVhdGlvbgogICMgQVVESVRfREVMX0dST1VQIC0gR3JvdXAgYWNjb3VudCBkZWxldGVkCiAgLSByZWNvcmRfdHlwZXM6IERFTF9HUk9VUAogICAgYWN0aW9uOiBkZWxldGVkLWdyb3VwLWFjY291bnQtZnJvbQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbaWQsIGFjY3RdCiAgICAgIHdoYXQ6IGFjY291bnQKICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIDw8OiAqZWNzLWdyb3VwLW1vZGlmaWNhdGlvbi1tYXBwaW5ncwogICAgICB0eXBlOgogICAgICAgIC0gZ3JvdXAKICAgICAgICAtIGRlbGV0aW9uCiAgIyBBVURJVF9ERUxfVVNFUiAtIFVzZXIgYWNjb3VudCBkZWxldGVkCiAgLSByZWNvcmRfdHlwZXM6IERFTF9VU0VSCiAgICBhY3Rpb246IGRlbGV0ZWQtdXNlci1hY2NvdW50CiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IFtpZCwgYWNjdF0KICAgICAgd2hhdDogYWNjb3VudAogICAgZWNzOgogICAgICA8PDogKmVjcy1pYW0KICAgICAgPDw6ICplY3MtdXNlci1tb2RpZmljYXRpb24tbWFwcGluZ3MKICAgICAgdHlwZToKICAgICAgICAtIHVzZXIKICAgICAgICAtIGRlbGV0aW9uCiAgIyBBVURJVF9HUlBfTUdNVCAtIEdyb3VwIGFjY291bnQgYXR0ciB3YXMgbW9kaWZpZWQKICAtIHJlY29yZF90eXBlczogR1JQX01HTVQKICAgIGFjdGlvbjogbW9kaWZpZWQtZ3JvdXAtYWNjb3VudAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbaWQsIGFjY3RdCiAgICAgIHdoYXQ6IGFjY291bnQKICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIHR5cGU6CiAgICAgICAgLSBncm91cAogICAgICAgIC0gY2hhbmdlCiAgICAgIG1hcHBpbmdzOgogICAgICAgIC0gZnJvbTogc3ViamVjdC5wcmltYXJ5CiAgICAgICAgICB0bzogdXNlcgogICAgICAgIC0gZnJvbTogc3ViamVjdC5zZWNvbmRhcnkKICAgICAgICAgIHRvOiBncm91cAogICAgICAgIC0gZnJvbTogdWlkLnVpZAogICAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCiAgIyBBVURJVF9ST0xFX0FTU0lHTiAtIEFkbWluIGFzc2lnbmVkIHVzZXIgdG8gcm9sZQogIC0gcmVjb3JkX3R5cGVzOiBST0xFX0FTU0lHTgogICAgYWN0aW9uOiBhc3NpZ25lZC11c2VyLXJvbGUtdG8KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogW2lkLCBhY2N0XQogICAgICB3aGF0OiBhY2NvdW50CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWlhbQogICAgICB0eXBlOgogICAgICAgIC0gdXNlcgogICAgICAgIC0gY2hhbmdlCiAgIyBBVURJVF9ST0xFX01PRElGWSAtIEFkbWluIG1vZGlmaWVkIGEgcm9sZQogIC0gcmVjb3JkX3R5cGVzOiBST0xFX01PRElGWQogICAgYWN0aW9uOiBtb2RpZmllZC1yb2xlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWlhbQogICAgICB0eXBlOgogICAgICAgIC0gY2hhbmdlCiAgIyBBVURJVF9ST0xFX1JFTU9WRSAtIEFkbWluIHJlbW92ZWQgdXNlciBmcm9tIHJvbGUKICAtIHJlY29yZF90eXBlczogUk9MRV9SRU1PVkUKICAgIGFjdGlvbjogcmVtb3ZlZC11c2VyLXJvbGUtZnJvbQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbaWQsIGFjY3RdCiAgICAgIHdoYXQ6IGFjY291bnQKICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIHR5cGU6CiAgICAgICAgLSB1c2VyCiAgICAgICAgLSBjaGFuZ2UKICAjIEFVRElUX1VTRVJfTUdNVCAtIFVzZXIgYWNjdCBhdHRyaWJ1dGUgY2hhbmdlCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBVU0VSX01HTVQKICAgIGFjdGlvbjogbW9kaWZpZWQtdXNlci1hY2NvdW50CiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWlhbQogICAgICB0eXBlOgogICAgICAgIC0gdXNlcgogICAgICAgIC0gY2hhbmdlCiAgICAgIG1hcHBpbmdzOgogICAgICAgIC0gZnJvbTogc3ViamVjdC5wcmltYXJ5CiAgICAgICAgICB0bzogdXNlcgogICAgICAgIC0gZnJvbTogc3ViamVjdC5zZWNvbmRhcnkKICAgICAgICAgIHRvOiB1c2VyLnRhcmdldAogICAgICAgIC0gZnJvbTogdWlkLnVpZAogICAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCiAgIyBBVURJVF9VU0VSX0NIQVVUSFRPSyAtIFVzZXIgYWNjdCBwYXNzd29yZCBvciBwaW4gY2hhbmdlZAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9DSEFVVEhUT0sKICAgIGFjdGlvbjogY2hhbmdlZC1wYXNzd29yZAogICAgZWNzOgogICAgICA8PDogKmVjcy1pYW0KICAgICAgdHlwZToKICAgICAgICAtIHVzZXIKICAgICAgICAtIGNoYW5nZQogICAgICBtYXBwaW5nczoKICAgICAgICAtIGZyb206IHN1YmplY3QucHJpbWFyeQogICAgICAgICAgdG86IHVzZXIKICAgICAgICAtIGZyb206IHVpZC51aWQKICAgICAgICAgIHRvOiB1c2VyLmVmZmVjdGl2ZQogICAgICAgIC0gZnJvbTogc3ViamVjdC5zZWNvbmRhcnkKICAgICAgICAgIHRvOiB1c2VyLnRhcmdldAoKICAjIEFVRElUX0dSUF9DSEFVVEhUT0sgLSBHcm91cCBhY2N0IHBhc3N3b3JkIG9yIHBpbiBjaGFuZ2VkCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBHUlBfQ0hBVVRIVE9LCiAgICBhY3Rpb246IGNoYW5nZWQtZ3JvdXAtcGFzc3dvcmQKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogYWNjdAogICAgICB3aGF0OiB1c2VyLXNlc3Npb24KICAgIGVjczoKICAgICAgPDw6ICplY3MtaWFtCiAgICAgIHR5cGU6CiAgICAgICAgLSBncm91cAogICAgICAgIC0gY2hhbmdlCiAgICAgIG1hcHBpbmdzOgogICAgICAgIC0gZnJvbTogc3ViamVjdC5wcmltYXJ5CiAgICAgICAgICB0bzogdXNlcgogICAgICAgIC0gZnJvbTogdWlkLnVpZAogICAgICAgICAgdG86IHVzZXIuZWZmZWN0aXZlCiAgICAgICAgLSBmcm9tOiBzdWJqZWN0LnNlY29uZGFyeQogICAgICAgICAgdG86IGdyb3VwCgogICMgQXV0aGVudGljYXRpb24gcmVsYXRlZCBldmVudHMKCiAgIyBBVURJVF9DUkVEX0FDUSAtIFVzZXIgY3JlZGVudGlhbCBhY3F1aXJlZAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogQ1JFRF9BQ1EKICAgIGFjdGlvbjogYWNxdWlyZWQtY3JlZGVudGlhbHMKICAgIGVjczogKmVjcy1hdXRoCiAgIyBBVURJVF9DUkVEX0RJU1AgLSBVc2VyIGNyZWRlbnRpYWwgZGlzcG9zZWQKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IENSRURfRElTUAogICAgYWN0aW9uOiBkaXNwb3NlZC1jcmVkZW50aWFscwogICAgZWNzOiAqZWNzLWF1dGgKICAjIEFVRElUX0NSRURfUkVGUiAtIFVzZXIgY3JlZGVudGlhbCByZWZyZXNoZWQKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IENSRURfUkVGUgogICAgYWN0aW9uOiByZWZyZXNoZWQtY3JlZGVudGlhbHMKICAgIGVjczogKmVjcy1hdXRoCiAgIyBBVURJVF9HUlBfQVVUSCAtIEF1dGhlbnRpY2F0aW9uIGZvciBncm91cCBwYXNzd29yZAogIC0gcmVjb3JkX3R5cGVzOiBHUlBfQVVUSAogICAgYWN0aW9uOiBhdXRoZW50aWNhdGVkLXRvLWdyb3VwCiAgICBlY3M6ICplY3MtYXV0aAogICMgQVVESVRfTE9HSU4gLSBEZWZpbmUgdGhlIGxvZ2luIGlkIGFuZCBpbmZvcm1hdGlvbgogIC0gcmVjb3JkX3R5cGVzOiBMT0dJTgogICAgYWN0aW9uOiBjaGFuZ2VkLWxvZ2luLWlkLXRvCiAgICBzdWJqZWN0OgogICAgICBwcmltYXJ5OiBbb2xkX2F1aWQsIG9sZC1hdWlkXQogICAgICBzZWNvbmRhcnk6IHVpZAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbbmV3LWF1aWQsIG5ld19hdWlkLCBhdWlkXQogICAgICB3aGF0OiB1c2VyLXNlc3Npb24KICAgIGVjczoKICAgICAgPDw6ICplY3MtYXV0aAogICAgICB0eXBlOiBzdGFydAogICAgICBtYXBwaW5nczoKICAgICAgICAtIGZyb206IHN1YmplY3QucHJpbWFyeQogICAgICAgICAgdG86IHVzZXIKICAgICAgICAtIGZyb206IG9iamVjdC5wcmltYXJ5CiAgICAgICAgICB0bzogdXNlci5lZmZlY3RpdmUKICAjIEFVRElUX1VTRVJfQUNDVCAtIFVzZXIgc3lzdGVtIGFjY2VzcyBhdXRob3JpemF0aW9uCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBVU0VSX0FDQ1QKICAgIGFjdGlvbjogd2FzLWF1dGhvcml6ZWQKICAgIGVjczogKmVjcy1hdXRoCiAgIyBBVURJVF9VU0VSX0FVVEggLSBVc2VyIHN5c3RlbSBhY2Nlc3MgYXV0aGVudGljYXRpb24KICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IFVTRVJfQVVUSAogICAgYWN0aW9uOiBhdXRoZW50aWNhdGVkCiAgICBlY3M6ICplY3MtYXV0aAogICMgQVVESVRfVVNFUl9FTkQgLSBVc2VyIHNlc3Npb24gZW5kCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBVU0VSX0VORAogICAgYWN0aW9uOiBlbmRlZC1zZXNzaW9uCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXNlc3Npb24KICAgICAgdHlwZTogZW5kCiAgIyBBVURJVF9VU0VSX0VSUiAtIFVzZXIgYWNjdCBzdGF0ZSBlcnJvcgogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9FUlIKICAgIGFjdGlvbjogZXJyb3IKICAgIHNvdXJjZV9pcDogW2FkZHJdCiAgICBlY3M6ICplY3MtYXV0aAogICMgQVVESVRfVVNFUl9MT0dJTiAtIFVzZXIgaGFzIGxvZ2dlZCBpbgogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9MT0dJTgogICAgYWN0aW9uOiBsb2dnZWQtaW4KICAgIHNvdXJjZV9pcDogW2FkZHJdCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWF1dGgKICAgICAgdHlwZTogc3RhcnQKICAjIEFVRElUX1VTRVJfTE9HT1VUIC0gVXNlciBoYXMgbG9nZ2VkIG91dAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9MT0dPVVQKICAgIGFjdGlvbjogbG9nZ2VkLW91dAogICAgZWNzOgogICAgICA8PDogKmVjcy1hdXRoCiAgICAgIHR5cGU6IGVuZAogICMgQVVESVRfVVNFUl9ST0xFX0NIQU5HRSAtIFVzZXIgY2hhbmdlZCB0byBhIG5ldyByb2xlCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBVU0VSX1JPTEVfQ0hBTkdFCiAgICBhY3Rpb246IGNoYW5nZWQtcm9sZS10bwogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBzZWxlY3RlZC1jb250ZXh0CiAgICAgIHdoYXQ6IHVzZXItc2Vzc2lvbgogICMgQVVESVRfVVNFUl9TVEFSVCAtIFVzZXIgc2Vzc2lvbiBzdGFydAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogVVNFUl9TVEFSVAogICAgYWN0aW9uOiBzdGFydGVkLXNlc3Npb24KICAgIHNvdXJjZV9pcDogW2FkZHJdCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXNlc3Npb24KICAgICAgdHlwZTogc3RhcnQKCiAgIyBIb3N0IHZpcnR1YWxpemF0aW9uIGV2ZW50cwoKICAjIEFVRElUX1ZJUlRfQ09OVFJPTCAtIFN0YXJ0LCBQYXVzZSwgU3RvcCBWTQogIC0gcmVjb3JkX3R5cGVzOiBWSVJUX0NPTlRST0wKICAgIGFjdGlvbjogaXNzdWVkLXZtLWNvbnRyb2wKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogb3AKICAgICAgc2Vjb25kYXJ5OiB2bQogICAgICB3aGF0OiB2aXJ0dWFsLW1hY2hpbmUKICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX0NSRUFURSAtIENyZWF0aW9uIG9mIGd1ZXN0IGltYWdlCiAgLSByZWNvcmRfdHlwZXM6IFZJUlRfQ1JFQVRFCiAgICBhY3Rpb246IGNyZWF0ZWQtdm0taW1hZ2UKICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX0RFU1RST1kgLSBEZXN0cnVjdGlvbiBvZiBndWVzdCBpbWFnZQogIC0gcmVjb3JkX3R5cGVzOiBWSVJUX0RFU1RST1kKICAgIGFjdGlvbjogZGVsZXRlZC12bS1pbWFnZQogICAgZWNzOiAqZWNzLWhvc3QKICAjIEFVRElUX1ZJUlRfSU5URUdSSVRZX0NIRUNLIC0gR3Vlc3QgaW50ZWdyaXR5IHJlc3VsdHMKICAtIHJlY29yZF90eXBlczogVklSVF9JTlRFR1JJVFlfQ0hFQ0sKICAgIGFjdGlvbjogY2hlY2tlZC1pbnRlZ3JpdHktb2YKICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX01BQ0hJTkVfSUQgLSBCaW5kaW5nIG9mIGxhYmVsIHRvIFZNCiAgLSByZWNvcmRfdHlwZXM6IFZJUlRfTUFDSElORV9JRAogICAgYWN0aW9uOiBhc3NpZ25lZC12bS1pZAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiB2bQogICAgICB3aGF0OiB2aXJ0dWFsLW1hY2hpbmUKICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX01JR1JBVEVfSU4gLSBJbmJvdW5kIGd1ZXN0IG1pZ3JhdGlvbiBpbmZvCiAgLSByZWNvcmRfdHlwZXM6IFZJUlRfTUlHUkFURV9JTgogICAgYWN0aW9uOiBtaWdyYXRlZC12bS1mcm9tCiAgICBlY3M6ICplY3MtaG9zdAogICMgQVVESVRfVklSVF9NSUdSQVRFX09VVCAtIE91dGJvdW5kIGd1ZXN0IG1pZ3JhdGlvbiBpbmZvCiAgLSByZWNvcmRfdHlwZXM6IFZJUlRfTUlHUkFURV9PVVQKICAgIGFjdGlvbjogbWlncmF0ZWQtdm0tdG8KICAgIGVjczogKmVjcy1ob3N0CiAgIyBBVURJVF9WSVJUX1JFU09VUkNFIC0gUmVzb3VyY2UgYXNzaWdubWVudAogIC0gcmVjb3JkX3R5cGVzOiBWSVJUX1JFU09VUkNFCiAgICBhY3Rpb246IGFzc2lnbmVkLXZtLXJlc291cmNlCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IHJlc3JjCiAgICAgIHNlY29uZGFyeTogdm0KICAgICAgd2hhdDogdmlydHVhbC1tYWNoaW5lCiAgICBlY3M6ICplY3MtaG9zdAoKICAjIFVzZXJzcGFjZSBwcm9jZXNzIGV2ZW50cwoKICAjIEFVRElUX0NIR1JQX0lEIC0gVXNlciBzcGFjZSBncm91cCBJRCBjaGFuZ2VkCiAgLSByZWNvcmRfdHlwZXM6IENIR1JQX0lECiAgICBhY3Rpb246IGNoYW5nZWQtZ3JvdXAKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX0NIVVNFUl9JRCAtIENoYW5nZWQgdXNlciBJRCBzdXBwbGVtZW50YWwgZGF0YQogIC0gcmVjb3JkX3R5cGVzOiBDSFVTRVJfSUQKICAgIGFjdGlvbjogY2hhbmdlZC11c2VyLWlkCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9URVNUIC0gVXNlZCBmb3IgdGVzdCBzdWNjZXNzIG1lc3NhZ2VzCiAgLSByZWNvcmRfdHlwZXM6IFRFU1QKICAgIGFjdGlvbjogc2VudC10ZXN0CiAgICBlY3M6ICplY3MtcHJvY2VzcwogICMgQVVESVRfVFJVU1RFRF9BUFAgLSBUcnVzdGVkIGFwcCBtc2cgLSBmcmVlc3R5bGUgdGV4dAogIC0gcmVjb3JkX3R5cGVzOiBUUlVTVEVEX0FQUAogICAgYWN0aW9uOiB1bmtub3duCiAgICBlY3M6ICplY3MtcHJvY2VzcwogICMgQVVESVRfVVNFUl9DTUQgLSBVc2VyIHNoZWxsIGNvbW1hbmQgYW5kIGFyZ3MKICAtIHJlY29yZF90eXBlczogVVNFUl9DTUQKICAgIGFjdGlvbjogcmFuLWNvbW1hbmQKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogY21kCiAgICAgIHdoYXQ6IHByb2Nlc3MKICAgIGRlc2NyaXB0aW9uOiA+CiAgICAgIFRoZXNlIG1lc3NhZ2VzIGFyZSBmcm9tIHVzZXItc3BhY2UgYXBwcywgbGlrZSBzdWRvLCB0aGF0IGxvZyBjb21tYW5kcwogICAgICBiZWluZyBydW4gYnkgYSB1c2VyLiBUaGUgdWlkIGNvbnRhaW5lZCBpbiB0aGVzZSBtZXNzYWdlcyBpcyB1c2VyJ3MgVUlEIGF0CiAgICAgIHRoZSB0aW1lIHRoZSBjb21tYW5kIHdhcyBydW4uIEl0IGlzIG5vdCB0aGUgInRhcmdldCIgVUlEIHVzZWQgdG8gcnVuIHRoZQogICAgICBjb21tYW5kLCB3aGljaCBpcyBub3JtYWxseSByb290LgogICAgZWNzOgogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IHN0YXJ0CgogICMgSG9zdC1sZXZlbCBldmVudHMKCiAgIyBBVURJVF9TWVNURU1fQk9PVCAtIFN5c3RlbSBib290CiAgLSByZWNvcmRfdHlwZXM6IFNZU1RFTV9CT09UCiAgICBhY3Rpb246IGJvb3RlZC1zeXN0ZW0KICAgIG9iamVjdDoKICAgICAgd2hhdDogc3lzdGVtCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWhvc3QKICAgICAgdHlwZTogc3RhcnQKICAjIEFVRElUX1NZU1RFTV9SVU5MRVZFTCAtIFN5c3RlbSBydW5sZXZlbCBjaGFuZ2UKICAtIHJlY29yZF90eXBlczogU1lTVEVNX1JVTkxFVkVMCiAgICBhY3Rpb246IGNoYW5nZWQtdG8tcnVubGV2ZWwKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogbmV3LWxldmVsCiAgICAgIHdoYXQ6IHN5c3RlbQogICAgZWNzOgogICAgICA8PDogKmVjcy1ob3N0CiAgICAgIHR5cGU6IGNoYW5nZQogICMgQVVESVRfU1lTVEVNX1NIVVRET1dOIC0gU3lzdGVtIHNodXRkb3duCiAgLSByZWNvcmRfdHlwZXM6IFNZU1RFTV9TSFVURE9XTgogICAgYWN0aW9uOiBzaHV0ZG93bi1zeXN0ZW0KICAgIG9iamVjdDoKICAgICAgd2hhdDogc3lzdGVtCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLWhvc3QKICAgICAgdHlwZTogZW5kCgogICMgU2VydmljZS1sZXZlbCBldmVudHMKCiAgIyBBVURJVF9TRVJWSUNFX1NUQVJUIC0gU2VydmljZSAoZGFlbW9uKSBzdGFydAogIC0gcmVjb3JkX3R5cGVzOiBTRVJWSUNFX1NUQVJUCiAgICBhY3Rpb246IHN0YXJ0ZWQtc2VydmljZQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiB1bml0CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBzdGFydAogICMgQVVESVRfU0VSVklDRV9TVE9QIC0gU2VydmljZSAoZGFlbW9uKSBzdG9wCiAgLSByZWNvcmRfdHlwZXM6IFNFUlZJQ0VfU1RPUAogICAgYWN0aW9uOiBzdG9wcGVkLXNlcnZpY2UKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogdW5pdAogICAgICB3aGF0OiBzZXJ2aWNlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogc3RvcAoKICAjIEF1ZGl0ZCBpbnRlcm5hbCBldmVudHMKCiAgIyBBVURJVF9DT05GSUdfQ0hBTkdFIC0gQXVkaXQgc3lzdGVtIGNvbmZpZ3VyYXRpb24gY2hhbmdlCiAgLSByZWNvcmRfdHlwZXM6IENPTkZJR19DSEFOR0UKICAgIGFjdGlvbjogY2hhbmdlZC1hdWRpdC1jb25maWd1cmF0aW9uCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6CiAgICAgICAgW29wLCBrZXksIGF1ZGl0X2VuYWJsZWQsIGF1ZGl0X3BpZCwgYXVkaXRfYmFja2xvZ19saW1pdCwgYXVkaXRfZmFpbHVyZV0KICAgICAgd2hhdDogYXVkaXQtY29uZmlnCiAgICBlY3M6CiAgICAgIGNhdGVnb3J5OiBbcHJvY2VzcywgY29uZmlndXJhdGlvbl0KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9EQUVNT05fQUJPUlQgLSBEYWVtb24gZXJyb3Igc3RvcCByZWNvcmQKICAtIHJlY29yZF90eXBlczogREFFTU9OX0FCT1JUCiAgICBhY3Rpb246IGFib3J0ZWQtYXVkaXRkLXN0YXJ0dXAKICAgIG9iamVjdDoKICAgICAgd2hhdDogc2VydmljZQogICAgZWNzOgogICAgICA8PDogKmVjcy1wcm9jZXNzCiAgICAgIHR5cGU6IHN0b3AKICAjIEFVRElUX0RBRU1PTl9BQ0NFUFQgLSBBdWRpdGQgYWNjZXB0ZWQgcmVtb3RlIGNvbm5lY3Rpb24KICAtIHJlY29yZF90eXBlczogREFFTU9OX0FDQ0VQVAogICAgYWN0aW9uOiByZW1vdGUtYXVkaXQtY29ubmVjdGVkCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtbmV0d29yawogICAgICB0eXBlOgogICAgICAgIC0gY29ubmVjdGlvbgogICAgICAgIC0gc3RhcnQKICAjIEFVRElUX0RBRU1PTl9DTE9TRSAtIEF1ZGl0ZCBjbG9zZWQgcmVtb3RlIGNvbm5lY3Rpb24KICAtIHJlY29yZF90eXBlczogREFFTU9OX0NMT1NFCiAgICBhY3Rpb246IHJlbW90ZS1hdWRpdC1kaXNjb25uZWN0ZWQKICAgIG9iamVjdDoKICAgICAgd2hhdDogc2VydmljZQogICAgZWNzOgogICAgICA8PDogKmVjcy1uZXR3b3JrCiAgICAgIHR5cGU6CiAgICAgICAgLSBjb25uZWN0aW9uCiAgICAgICAgLSBzdGFydAogICMgQVVESVRfREFFTU9OX0NPTkZJRyAtIERhZW1vbiBjb25maWcgY2hhbmdlCiAgLSByZWNvcmRfdHlwZXM6IERBRU1PTl9DT05GSUcKICAgIGFjdGlvbjogY2hhbmdlZC1hdWRpdGQtY29uZmlndXJhdGlvbgogICAgb2JqZWN0OgogICAgICB3aGF0OiBzZXJ2aWNlCiAgICBlY3M6CiAgICAgIGNhdGVnb3J5OiBbcHJvY2VzcywgY29uZmlndXJhdGlvbl0KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9EQUVNT05fRU5EIC0gRGFlbW9uIG5vcm1hbCBzdG9wIHJlY29yZAogIC0gcmVjb3JkX3R5cGVzOiBEQUVNT05fRU5ECiAgICBhY3Rpb246IHNodXRkb3duLWF1ZGl0CiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBzdG9wCiAgIyBBVURJVF9EQUVNT05fRVJSIC0gQXVkaXRkIGludGVybmFsIGVycm9yCiAgLSByZWNvcmRfdHlwZXM6IERBRU1PTl9FUlIKICAgIGFjdGlvbjogYXVkaXQtZXJyb3IKICAgIG9iamVjdDoKICAgICAgd2hhdDogc2VydmljZQogICAgZWNzOiAqZWNzLXByb2Nlc3MKICAjIEFVRElUX0RBRU1PTl9SRUNPTkZJRyAtIEF1ZGl0ZCBzaG91bGQgcmVjb25maWd1cmUKICAtIHJlY29yZF90eXBlczogREFFTU9OX1JFQ09ORklHCiAgICBhY3Rpb246IHJlY29uZmlndXJlZC1hdWRpdGQKICAgIG9iamVjdDoKICAgICAgd2hhdDogc2VydmljZQogICAgZWNzOgogICAgICBjYXRlZ29yeTogW3Byb2Nlc3MsIGNvbmZpZ3VyYXRpb25dCiAgICAgIHR5cGU6IGluZm8KICAjIEFVRElUX0RBRU1PTl9SRVNVTUUgLSBBdWRpdGQgc2hvdWxkIHJlc3VtZSBsb2dnaW5nCiAgLSByZWNvcmRfdHlwZXM6IERBRU1PTl9SRVNVTUUKICAgIGFjdGlvbjogcmVzdW1lZC1hdWRpdC1sb2dnaW5nCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX0RBRU1PTl9ST1RBVEUgLSBBdWRpdGQgc2hvdWxkIHJvdGF0ZSBsb2dzCiAgLSByZWNvcmRfdHlwZXM6IERBRU1PTl9ST1RBVEUKICAgIGFjdGlvbjogcm90YXRlZC1hdWRpdC1sb2dzCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IHNlcnZpY2UKICAgIGVjczoKICAgICAgPDw6ICplY3MtcHJvY2VzcwogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX0RBRU1PTl9TVEFSVCAtIERhZW1vbiBzdGFydHVwIHJlY29yZAogIC0gcmVjb3JkX3R5cGVzOiBEQUVNT05fU1RBUlQKICAgIGFjdGlvbjogc3RhcnRlZC1hdWRpdAogICAgb2JqZWN0OgogICAgICB3aGF0OiBzZXJ2aWNlCiAgICBlY3M6CiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogc3RhcnQKICAjIEFVRElUX0tFUk5FTCAtIEFzeW5jaHJvbm91cyBhdWRpdCByZWNvcmQuIE5PVCBBIFJFUVVFU1QuCiAgLSByZWNvcmRfdHlwZXM6IEtFUk5FTAogICAgYWN0aW9uOiBpbml0aWFsaXplZC1hdWRpdC1zdWJzeXN0ZW0KICAgIGVjczogKmVjcy1wcm9jZXNzCgogICMgQ29uZmlndXJhdGlvbiBjaGFuZ2UgZXZlbnRzCgogICMgQVVESVRfVVNZU19DT05GSUcgLSBVc2VyIHNwYWNlIHN5c3RlbSBjb25maWcgY2hhbmdlCiAgLSByZWNvcmRfdHlwZXM6IFVTWVNfQ09ORklHCiAgICBhY3Rpb246IGNoYW5nZWQtY29uZmlndXJhdGlvbgogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBvcAogICAgICB3aGF0OiBzeXN0ZW0KICAgIGVjczoKICAgICAgY2F0ZWdvcnk6IGNvbmZpZ3VyYXRpb24KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9ORVRGSUxURVJfQ0ZHIC0gTmV0ZmlsdGVyIGNoYWluIG1vZGlmaWNhdGlvbnMKICAtIHJlY29yZF90eXBlczogTkVURklMVEVSX0NGRwogICAgYWN0aW9uOiBsb2FkZWQtZmlyZXdhbGwtcnVsZS10bwogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiB0YWJsZQogICAgICB3aGF0OiBmaXJld2FsbAogICAgZWNzOgogICAgICBjYXRlZ29yeTogY29uZmlndXJhdGlvbgogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX0ZFQVRVUkVfQ0hBTkdFIC0gYXVkaXQgbG9nIGxpc3RpbmcgZmVhdHVyZSBjaGFuZ2VzCiAgLSByZWNvcmRfdHlwZXM6IEZFQVRVUkVfQ0hBTkdFCiAgICBhY3Rpb246IGNoYW5nZWQtYXVkaXQtZmVhdHVyZQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBmZWF0dXJlCiAgICAgIHdoYXQ6IHN5c3RlbQogICAgZWNzOgogICAgICBjYXRlZ29yeTogY29uZmlndXJhdGlvbgogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX1JFUExBQ0UgLSBSZXBsYWNlIGF1ZGl0ZCBpZiB0aGlzIHBhY2tldCB1bmFuc3dlcmQKCiAgIyBUVFkgZXZlbnRzCgogIC0gcmVjb3JkX3R5cGVzOgogICAgICAjIEFVRElUX1RUWSAtIElucHV0IG9uIGFuIGFkbWluaXN0cmF0aXZlIFRUWQogICAgICAtIFRUWQogICAgICAjIEFVRElUX1VTRVJfVFRZIC0gTm9uLUlDQU5PTiBUVFkgaW5wdXQgbWVhbmluZwogICAgICAtIFVTRVJfVFRZCiAgICBhY3Rpb246IHR5cGVkCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IGRhdGEKICAgICAgd2hhdDoga2V5c3Ryb2tlcwogICAgaG93OiBbY29tbSwgZXhlXQoKICAjIFBvbGljeSBldmVudHMKCiAgIyBBVURJVF9BVkMgLSBTRSBMaW51eCBhdmMgZGVuaWFsIG9yIGdyYW50IChzZWxpbnV4KQogIC0gcmVjb3JkX3R5cGVzOiBBVkMKICAgIGFjdGlvbjogdmlvbGF0ZWQtc2VsaW51eC1wb2xpY3kKICAgIHN1YmplY3Q6CiAgICAgIHByaW1hcnk6IHNjb250ZXh0CiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IHRjb250ZXh0CiAgICAgIHNlY29uZGFyeTogdGNsYXNzCiAgICBoYXNfZmllbGRzOgogICAgICAtIHNlcmVzdWx0CiAgIyBBVURJVF9BVkMgLSBTRSBMaW51eCBhdmMgZGVuaWFsIG9yIGdyYW50IChhcHBhcm1vcikKICAtIHJlY29yZF90eXBlczogQVZDCiAgICBhY3Rpb246IHZpb2xhdGVkLWFwcGFybW9yLXBvbGljeQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBvcGVyYXRpb24KICAgICAgc2Vjb25kYXJ5OiBbcmVxdWVzdGVkX21hc2ssIGRlbmllZF9tYXNrLCBjYXBuYW1lXQogICAgICB3aGF0OiBwb2xpY3kKICAgIGhhc19maWVsZHM6CiAgICAgIC0gYXBwYXJtb3IKICAjIEFVRElUX0ZBTk9USUZZIC0gRmFub3RpZnkgYWNjZXNzIGRlY2lzaW9uCiAgLSByZWNvcmRfdHlwZXM6IEZBTk9USUZZCiAgICBhY3Rpb246IGRlY2lkZWQtZmlsZS1hY2Nlc3MKICAgIG9iamVjdDoKICAgICAgd2hhdDogZmlsZQogICMgQVVESVRfRlNfUkVMQUJFTCAtIEZpbGVzeXN0ZW0gcmVsYWJlbGVkCiAgLSByZWNvcmRfdHlwZXM6IEZTX1JFTEFCRUwKICAgIGFjdGlvbjogcmVsYWJlbGVkLWZpbGVzeXN0ZW0KICAgIG9iamVjdDoKICAgICAgd2hhdDogbWFjLWNvbmZpZwogICMgQVVESVRfTEFCRUxfTEVWRUxfQ0hBTkdFIC0gT2JqZWN0J3MgbGV2ZWwgd2FzIGNoYW5nZWQKICAtIHJlY29yZF90eXBlczogTEFCRUxfTEVWRUxfQ0hBTkdFCiAgICBhY3Rpb246IG1vZGlmaWVkLWxldmVsLW9mCiAgICBvYmplY3Q6CiAgICAgIHByaW1hcnk6IHByaW50ZXIKICAgICAgd2hhdDogcHJpbnRlcgogICMgQVVESVRfTEFCRUxfT1ZFUlJJREUgLSBBZG1pbiBpcyBvdmVycmlkaW5nIGEgbGFiZWwKICAtIHJlY29yZF90eXBlczogTEFCRUxfT1ZFUlJJREUKICAgIGFjdGlvbjogb3ZlcnJvZGUtbGFiZWwtb2YKICAgIG9iamVjdDoKICAgICAgd2hhdDogbWFjLWNvbmZpZwogICMgQVVESVRfTUFDX0NIRUNLIC0gVXNlciBzcGFjZSBNQUMgZGVjaXNpb24gcmVzdWx0cwogIC0gcmVjb3JkX3R5cGVzOiBNQUNfQ0hFQ0sKICAgIGFjdGlvbjogbWFjLXBlcm1pc3Npb24KICAjIEFVRElUX01BQ19DT05GSUdfQ0hBTkdFIC0gQ2hhbmdlcyB0byBib29sZWFucwogIC0gcmVjb3JkX3R5cGVzOiBNQUNfQ09ORklHX0NIQU5HRQogICAgYWN0aW9uOiBjaGFuZ2VkLXNlbGludXgtYm9vbGVhbgogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBib29sCiAgICAgIHdoYXQ6IG1hYy1jb25maWcKICAgIGVjczoKICAgICAgY2F0ZWdvcnk6IGNvbmZpZ3VyYXRpb24KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9NQUNfUE9MSUNZX0xPQUQgLSBQb2xpY3kgZmlsZSBsb2FkCiAgLSByZWNvcmRfdHlwZXM6IE1BQ19QT0xJQ1lfTE9BRAogICAgYWN0aW9uOiBsb2FkZWQtc2VsaW51eC1wb2xpY3kKICAgIG9iamVjdDoKICAgICAgd2hhdDogbWFjLWNvbmZpZwogICAgZWNzOgogICAgICBjYXRlZ29yeTogY29uZmlndXJhdGlvbgogICAgICB0eXBlOiBhY2Nlc3MKICAjIEFVRElUX01BQ19TVEFUVVMgLSBDaGFuZ2VkIGVuZm9yY2luZyxwZXJtaXNzaXZlLG9mZgogIC0gcmVjb3JkX3R5cGVzOiBNQUNfU1RBVFVTCiAgICBhY3Rpb246IGNoYW5nZWQtc2VsaW51eC1lbmZvcmNlbWVudAogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBlbmZvcmNpbmcKICAgICAgd2hhdDogbWFjLWNvbmZpZwogICAgZWNzOgogICAgICBjYXRlZ29yeTogY29uZmlndXJhdGlvbgogICAgICB0eXBlOiBjaGFuZ2UKICAjIEFVRElUX1VTRVJfQVZDIC0gVXNlciBzcGFjZSBhdmMgbWVzc2FnZQogIC0gcmVjb3JkX3R5cGVzOiBVU0VSX0FWQwogICAgYWN0aW9uOiBhY2Nlc3MtcGVybWlzc2lvbgogICMgQVVESVRfVVNFUl9NQUNfQ09ORklHX0NIQU5HRSAtIENoYW5nZSBtYWRlIHRvIE1BQyBwb2xpY3kKICAtIHJlY29yZF90eXBlczogVVNFUl9NQUNfQ09ORklHX0NIQU5HRQogICAgYWN0aW9uOiBjaGFuZ2VkLW1hYy1jb25maWd1cmF0aW9uCiAgICBvYmplY3Q6CiAgICAgIHdoYXQ6IG1hYy1jb25maWcKICAgIGVjczoKICAgICAgY2F0ZWdvcnk6IGNvbmZpZ3VyYXRpb24KICAgICAgdHlwZTogY2hhbmdlCiAgIyBBVURJVF9VU0VSX01BQ19QT0xJQ1lfTE9BRCAtIFVzZXJzcGMgZGFlbW9uIGxvYWRlZCBwb2xpYwogIC0gcmVjb3JkX3R5cGVzOiBVU0VSX01BQ19QT0xJQ1lfTE9BRAogICAgYWN0aW9uOiBsb2FkZWQtbWFjLXBvbGljeQogICAgb2JqZWN0OgogICAgICB3aGF0OiBtYWMtY29uZmlnCiAgICBlY3M6CiAgICAgIGNhdGVnb3J5OiBjb25maWd1cmF0aW9uCiAgICAgIHR5cGU6IGFjY2VzcwogICMgQVVESVRfVVNFUl9TRUxJTlVYX0VSUiAtIFNFIExpbnV4IHVzZXIgc3BhY2UgZXJyb3IKICAtIHJlY29yZF90eXBlczogVVNFUl9TRUxJTlVYX0VSUgogICAgYWN0aW9uOiBhY2Nlc3MtZXJyb3IKICAjIEFVRElUX1NFQ0NPTVAgLSBTZWN1cmUgQ29tcHV0aW5nIGV2ZW50CiAgLSByZWNvcmRfdHlwZXM6IFNFQ0NPTVAKICAgIGFjdGlvbjogdmlvbGF0ZWQtc2VjY29tcC1wb2xpY3kKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogc3lzY2FsbAogICAgICB3aGF0OiBwcm9jZXNzCiAgIyBBVURJVF9TRUxJTlVYX0VSUiAtIEludGVybmFsIFNFIExpbnV4IEVycm9ycwogIC0gYWN0aW9uOiBjYXVzZWQtbWFjLXBvbGljeS1lcnJvcgogICAgb2JqZWN0OgogICAgICB3aGF0OiBzeXN0ZW0KICAgIHJlY29yZF90eXBlczogU0VMSU5VWF9FUlIKICAjIEFVRElUX0FQUEFSTU9SX0FMTE9XRUQKICAjIEFVRElUX0FQUEFSTU9SX0RFTklFRAogICMgQVVESVRfQVBQQVJNT1JfRVJST1IKICAjIEFVRElUX0FWQ19QQVRIIC0gZGVudHJ5LCB2ZnNtb3VudCBwYWlyIGZyb20gYXZjCiAgIyBBVURJVF9BUFBBUk1PUl9BVURJVAogICMgQVVESVRfQVBQQVJNT1JfSElOVAogICMgQVVESVRfQVBQQVJNT1JfU1RBVFVTCiAgIyBBVURJVF9BUFBBUk1PUl9FUlJPUgogICMgQVVESVRfREVWX0FMTE9DIC0gRGV2aWNlIHdhcyBhbGxvY2F0ZWQKICAjIEFVRElUX0RFVl9ERUFMTE9DIC0gRGV2aWNlIHdhcyBkZWFsbG9jYXRlZAogICMgQVVESVRfTUFDX1VOTEJMX0FMTE9XIC0gTmV0TGFiZWw6IGFsbG93IHVubGFiZWxlZCB0cmFmZmljCiAgIyBBVURJVF9NQUNfQ0lQU09WNF9BREQgLSBOZXRMYWJlbDogYWRkIENJUFNPdjQgRE9JIGVudHJ5CiAgIyBBVURJVF9NQUNfQ0lQU09WNF9ERUwgLSBOZXRMYWJlbDogZGVsIENJUFNPdjQgRE9JIGVudHJ5CiAgIyBBVURJVF9NQUNfTUFQX0FERCAtIE5ldExhYmVsOiBhZGQgTFNNIGRvbWFpbiBtYXBwaW5nCiAgIyBBVURJVF9NQUNfTUFQX0RFTCAtIE5ldExhYmVsOiBkZWwgTFNNIGRvbWFpbiBtYXBwaW5nCiAgIyBBVURJVF9NQUNfSVBTRUNfRVZFTlQgLSBBdWRpdCBhbiBJUFNlYyBldmVudAogICMgQVVESVRfTUFDX1VOTEJMX1NUQ0FERCAtIE5ldExhYmVsOiBhZGQgYSBzdGF0aWMgbGFiZWwKICAjIEFVRElUX01BQ19VTkxCTF9TVENERUwgLSBOZXRMYWJlbDogZGVsIGEgc3RhdGljIGxhYmVsCiAgIyBBVURJVF9NQUNfQ0FMSVBTT19BREQgLSBOZXRMYWJlbDogYWRkIENBTElQU08gRE9JIGVudHJ5CiAgIyBBVURJVF9NQUNfQ0FMSVBTT19ERUwgLSBOZXRMYWJlbDogZGVsIENBTElQU08gRE9JIGVudHJ5CiAgIyBBVURJVF9VU0VSX0xBQkVMRURfRVhQT1JUIC0gT2JqZWN0IGV4cG9ydGVkIHdpdGggbGFiZWwKICAjIEFVRElUX1VTRVJfVU5MQUJFTEVEX0VYUE9SVCAtIE9iamVjdCBleHBvcnRlZCB3aXRob3V0IGxhYmVsCgogICMgQ3J5cHRvIGV2ZW50cwoKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICBhY3Rpb246IG5lZ290aWF0ZWQtY3J5cHRvLWtleQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBmcAogICAgICBzZWNvbmRhcnk6IFthZGRyLCBob3N0bmFtZV0KICAgICAgd2hhdDogdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IENSWVBUT19LRVlfVVNFUgogICAgc291cmNlX2lwOiBbYWRkcl0KICAgIGVjczogKmVjcy1wcm9jZXNzCiAgLSBhY3Rpb246IGNyeXB0by1vZmZpY2VyLWxvZ2dlZC1pbgogICAgcmVjb3JkX3R5cGVzOiBDUllQVE9fTE9HSU4KICAtIGFjdGlvbjogY3J5cHRvLW9mZmljZXItbG9nZ2VkLW91dAogICAgcmVjb3JkX3R5cGVzOiBDUllQVE9fTE9HT1VUCiAgICBlY3M6ICplY3MtcHJvY2VzcwogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIGFjdGlvbjogc3RhcnRlZC1jcnlwdG8tc2Vzc2lvbgogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBhZGRyCiAgICAgIHNlY29uZGFyeTogW3Jwb3J0XQogICAgcmVjb3JkX3R5cGVzOiBDUllQVE9fU0VTU0lPTgogICAgc291cmNlX2lwOiBbYWRkcl0KICAgIGVjczogKmVjcy1wcm9jZXNzCiAgLSBhY3Rpb246IGFjY2Vzcy1yZXN1bHQKICAgIHJlY29yZF90eXBlczogREFDX0NIRUNLCgogICMgQW5vbWFsaWVzCgogICMgQVVESVRfQU5PTV9BQkVORCAtIFByb2Nlc3MgZW5kZWQgYWJub3JtYWxseQogIC0gcmVjb3JkX3R5cGVzOiBBTk9NX0FCRU5ECiAgICBhY3Rpb246IGNyYXNoZWQtcHJvZ3JhbQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBbY29tbSwgZXhlXQogICAgICBzZWNvbmRhcnk6IHBpZAogICAgICB3aGF0OiBwcm9jZXNzCiAgICBob3c6IHNpZwogICAgZWNzOgogICAgICAjIGNvbnNpZGVyIGFkZGluZyBhbiBhbm9tYWx5IGNhdGVnb3J5IHdoZW4gd2UgaW50cm9kdWNlCiAgICAgICMgdG8gRUNTCiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogZW5kCiAgIyBBVURJVF9BTk9NX0VYRUMgLSBFeGVjdXRpb24gb2YgZmlsZQogIC0gcmVjb3JkX3R5cGVzOiBBTk9NX0VYRUMKICAgIGFjdGlvbjogYXR0ZW1wdGVkLWV4ZWN1dGlvbi1vZi1mb3JiaWRkZW4tcHJvZ3JhbQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBjbWQKICAgICAgd2hhdDogcHJvY2VzcwogICAgaG93OiB0ZXJtaW5hbAogICAgZWNzOgogICAgICAjIGNvbnNpZGVyIGFkZGluZyBhbiBhbm9tYWx5IGNhdGVnb3J5IHdoZW4gd2UgaW50cm9kdWNlCiAgICAgICMgdG8gRUNTCiAgICAgIDw8OiAqZWNzLXByb2Nlc3MKICAgICAgdHlwZTogc3RhcnQKICAjIEFVRElUX0FOT01fTElOSyAtIFN1c3BpY2lvdXMgdXNlIG9mIGZpbGUgbGlua3MKICAtIHJlY29yZF90eXBlczogQU5PTV9MSU5LCiAgICBhY3Rpb246IHVzZWQtc3VzcGljaW91cy1saW5rCiAgIyBBVURJVF9BTk9NX0xPR0lOX0ZBSUxVUkVTIC0gRmFpbGVkIGxvZ2luIGxpbWl0IHJlYWNoZWQKICAtIDw8OiAqbWFjcm8tdXNlci1zZXNzaW9uCiAgICByZWNvcmRfdHlwZXM6IEFOT01fTE9HSU5fRkFJTFVSRVMKICAgIGFjdGlvbjogZmFpbGVkLWxvZy1pbi10b28tbWFueS10aW1lcy10bwogICMgQVVESVRfQU5PTV9MT0dJTl9MT0NBVElPTiAtIExvZ2luIGZyb20gZm9yYmlkZGVuIGxvY2F0aW9uCiAgLSA8PDogKm1hY3JvLXVzZXItc2Vzc2lvbgogICAgcmVjb3JkX3R5cGVzOiBBTk9NX0xPR0lOX0xPQ0FUSU9OCiAgICBhY3Rpb246IGF0dGVtcHRlZC1sb2ctaW4tZnJvbS11bnVzdWFsLXBsYWNlLXRvCiAgIyBBVURJVF9BTk9NX0xPR0lOX1NFU1NJT05TIC0gTWF4IGNvbmN1cnJlbnQgc2Vzc2lvbnMgcmVhY2hlZAogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogQU5PTV9MT0dJTl9TRVNTSU9OUwogICAgYWN0aW9uOiBvcGVuZWQtdG9vLW1hbnktc2Vzc2lvbnMtdG8KICAjIEFVRElUX0FOT01fTE9HSU5fVElNRSAtIExvZ2luIGF0dGVtcHRlZCBhdCBiYWQgdGltZQogIC0gPDw6ICptYWNyby11c2VyLXNlc3Npb24KICAgIHJlY29yZF90eXBlczogQU5PTV9MT0dJTl9USU1FCiAgICBhY3Rpb246IGF0dGVtcHRlZC1sb2ctaW4tZHVyaW5nLXVudXN1YWwtaG91ci10bwogICMgQVVESVRfQU5PTV9QUk9NSVNDVU9VUyAtIERldmljZSBjaGFuZ2VkIHByb21pc2N1b3VzIG1vZGUKICAtIHJlY29yZF90eXBlczogQU5PTV9QUk9NSVNDVU9VUwogICAgYWN0aW9uOiBjaGFuZ2VkLXByb21pc2N1b3VzLW1vZGUtb24tZGV2aWNlICMgQ291bGQgYmUgZW50ZXJlZCBvciBleGl0ZWQgYmFzZWQgb24gcHJvbSBmaWVsZC4KICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogZGV2CiAgICAgIHdoYXQ6IG5ldHdvcmstZGV2aWNlCiAgIyBBVURJVF9BTk9NX1JCQUNfSU5URUdSSVRZX0ZBSUwgLSBSQkFDIGZpbGUgaW50ZWdyaXR5IGZhaWx1cmUKICAtIHJlY29yZF90eXBlczogQU5PTV9SQkFDX0lOVEVHUklUWV9GQUlMCiAgICBhY3Rpb246IHRlc3RlZC1maWxlLXN5c3RlbS1pbnRlZ3JpdHktb2YKICAgIG9iamVjdDoKICAgICAgcHJpbWFyeTogaG9zdG5hbWUKICAgICAgd2hhdDogZmlsZXN5c3RlbQogICMgQVVESVRfQU5PTV9MT0dJTl9BQ0NUIC0gTG9naW4gYXR0ZW1wdGVkIHRvIHdhdGNoZWQgYWNjdAogICMgQVVESVRfQU5PTV9NQVhfREFDIC0gTWF4IERBQyBmYWlsdXJlcyByZWFjaGVkCiAgIyBBVURJVF9BTk9NX01BWF9NQUMgLSBNYXggTUFDIGZhaWx1cmVzIHJlYWNoZWQKICAjIEFVRElUX0FOT01fQU1UVV9GQUlMIC0gQU1UVSBmYWlsdXJlCiAgIyBBVURJVF9BTk9NX1JCQUNfRkFJTCAtIFJCQUMgc2VsZiB0ZXN0IGZhaWx1cmUKICAjIEFVRElUX0FOT01fQ1JZUFRPX0ZBSUwgLSBDcnlwdG8gc3lzdGVtIHRlc3QgZmFpbHVyZQogICMgQVVESVRfQU5PTV9NS19FWEUgLSBNYWtlIGFuIGV4ZWN1dGFibGUKICAjIEFVRElUX0FOT01fQUNDRVNTX0ZTIC0gQWNjZXNzIG9mIGZpbGUgb3IgZGlyCiAgIyBBVURJVF9BTk9NX0FERF9BQ0NUIC0gQWRkaW5nIGFuIGFjY3QKICAjIEFVRElUX0FOT01fREVMX0FDQ1QgLSBEZWxldGluZyBhbiBhY2N0CiAgIyBBVURJVF9BTk9NX01PRF9BQ0NUIC0gQ2hhbmdpbmcgYW4gYWNjdAogICMgQVVESVRfQU5PTV9ST09UX1RSQU5TIC0gVXNlciBiZWNhbWUgcm9vdAogICMgQVVESVRfQU5PTV9MT0dJTl9TRVJWSUNFIC0gU2VydmljZSBhY2N0IGF0dGVtcHRlZCBsb2dpbgoKICAjIEFub21hbHkgcmVzcG9uc2VzCgogICMgQVVESVRfUkVTUF9BTk9NQUxZIC0gQW5vbWFseSBub3QgcmVhY3RlZCB0bwogICMgQVVESVRfUkVTUF9BTEVSVCAtIEFsZXJ0IGVtYWlsIHdhcyBzZW50CiAgIyBBVURJVF9SRVNQX0tJTExfUFJPQyAtIEtpbGwgcHJvZ3JhbQogICMgQVVESVRfUkVTUF9URVJNX0FDQ0VTUyAtIFRlcm1pbmF0ZSBzZXNzaW9uCiAgIyBBVURJVF9SRVNQX0FDQ1RfUkVNT1RFIC0gQWNjdCBsb2NrZWQgZnJvbSByZW1vdGUgYWNjZXNzCiAgIyBBVURJVF9SRVNQX0FDQ1RfTE9DS19USU1FRCAtIFVzZXIgYWNjdCBsb2NrZWQgZm9yIHRpbWUKICAjIEFVRElUX1JFU1BfQUNDVF9VTkxPQ0tfVElNRUQgLSBVc2VyIGFjY3QgdW5sb2NrZWQgZnJvbSB0aW1lCiAgIyBBVURJVF9SRVNQX0FDQ1RfTE9DSyAtIFVzZXIgYWNjdCB3YXMgbG9ja2VkCiAgIyBBVURJVF9SRVNQX1RFUk1fTE9DSyAtIFRlcm1pbmFsIHdhcyBsb2NrZWQKICAjIEFVRElUX1JFU1BfU0VCT09MIC0gU2V0IGFuIFNFIExpbnV4IGJvb2xlYW4KICAjIEFVRElUX1JFU1BfRVhFQyAtIEV4ZWN1dGUgYSBzY3JpcHQKICAjIEFVRElUX1JFU1BfU0lOR0xFIC0gR28gdG8gc2luZ2xlIHVzZXIgbW9kZQogICMgQVVESVRfUkVTUF9IQUxUIC0gdGFrZSB0aGUgc3lzdGVtIGRvd24KICAjIEFVRElUX1JFU1BfT1JJR0lOX0JMT0NLIC0gQWRkcmVzcyBibG9ja2VkIGJ5IGlwdGFibGVzCiAgIyBBVURJVF9SRVNQX09SSUdJTl9CTE9DS19USU1FRCAtIEFkZHJlc3MgYmxvY2tlZCBmb3IgdGltZQoKICAjIEF1ZGl0IHJ1bGUgZXZlbnRzCgogICMgQVVESVRfU1lTQ0FMTCAtIFN5c2NhbGwgZXZlbnQKICAjIEFVRElUX1BBVEggLSBGaWxlbmFtZSBwYXRoIGluZm9ybWF0aW9uCiAgIyBBVURJVF9JUEMgLSBJUEMgcmVjb3JkCiAgIyBBVURJVF9TT0NLRVRDQUxMIC0gc3lzX3NvY2tldGNhbGwgYXJndW1lbnRzCiAgIyBBVURJVF9TT0NLQUREUiAtIHNvY2thZGRyIGNvcGllZCBhcyBzeXNjYWxsIGFyZwogICMgQVVESVRfQ1dEIC0gQ3VycmVudCB3b3JraW5nIGRpcmVjdG9yeQogICMgQVVESVRfRVhFQ1ZFIC0gZXhlY3ZlIGFyZ3VtZW50cwogICMgQVVESVRfSVBDX1NFVF9QRVJNIC0gSVBDIG5ldyBwZXJtaXNzaW9ucyByZWNvcmQgdHlwZQogICMgQVVESVRfTVFfT1BFTiAtIFBPU0lYIE1RIG9wZW4gcmVjb3JkIHR5cGUKICAjIEFVRElUX01RX1NFTkRSRUNWLSBQT1NJWCBNUSBzZW5kL3JlY2VpdmUgcmVjb3JkIHR5cGUKICAjIEFVRElUX01RX05PVElGWSAtIFBPU0lYIE1RIG5vdGlmeSByZWNvcmQgdHlwZQogICMgQVVESVRfTVFfR0VUU0VUQVRUUiAtIFBPU0lYIE1RIGdldC9zZXQgYXR0cmlidXRlIHJlY29yZCB0eXBlCiAgIyBBVURJVF9GRF9QQUlSIC0gYXVkaXQgcmVjb3JkIGZvciBwaXBlL3NvY2tldHBhaXIKICAjIEFVRElUX09CSl9QSUQgLSBwdHJhY2UgdGFyZ2V0CiAgIyBBVURJVF9CUFJNX0ZDQVBTIC0gSW5mb3JtYXRpb24gYWJvdXQgZmNhcHMgaW5jcmVhc2luZyBwZXJtcwogICMgQVVESVRfQ0FQU0VUIC0gUmVjb3JkIHNob3dpbmcgYXJndW1lbnQgdG8gc3lzX2NhcHNldAogICMgQVVESVRfTU1BUCAtIFJlY29yZCBzaG93aW5nIGRlc2NyaXB0b3IgYW5kIGZsYWdzIGluIG1tYXAKICAjIEFVRElUX05FVEZJTFRFUl9QS1QgLSBQYWNrZXRzIHRyYXZlcnNpbmcgbmV0ZmlsdGVyIGNoYWlucwoKICAjIEludGVncml0eSBjaGVja3MKCiAgIyBBVURJVF9JTlRFR1JJVFlfREFUQSAtIERhdGEgaW50ZWdyaXR5IHZlcmlmaWNhdGlvbgogICMgQVVESVRfSU5URUdSSVRZX01FVEFEQVRBIC0gTWV0YWRhdGEgaW50ZWdyaXR5IHZlcmlmaWNhdGlvbgogICMgQVVESVRfSU5URUdSSVRZX1NUQVRVUyAtIEludGVncml0eSBlbmFibGUgc3RhdHVzCiAgIyBBVURJVF9JTlRFR1JJVFlfSEFTSCAtIEludGVncml0eSBIQVNIIHR5cGUKICAjIEFVRElUX0lOVEVHUklUWV9QQ1IgLSBQQ1IgaW52YWxpZGF0aW9uIG1zZ3MKICAjIEFVRElUX0lOVEVHUklUWV9SVUxFIC0gUG9saWN5IHJ1bGUKCiAgIyBWYXJpb3VzCgogICMgQVVESVRfVVNFUiAtIE1lc3NhZ2UgZnJvbSB1c2Vyc3BhY2UgLS0gZGVwcmVjYXRlZAogIC0gcmVjb3JkX3R5cGVzOiBVU0VSCiAgICBhY3Rpb246IHNlbnQtbWVzc2FnZQogICAgb2JqZWN0OgogICAgICBwcmltYXJ5OiBhZGRyCgogICMgQVVESVRfU09GVFdBUkVfVVBEQVRFIC0gUGFja2FnZSBtYW5hZ2VtZW50CiAgLSByZWNvcmRfdHlwZXM6IFNPRlRXQVJFX1VQREFURQogICAgYWN0aW9uOiBwYWNrYWdlLXVwZGF0ZWQKICAgIGVjczoKICAgICAgY2F0ZWdvcnk6IHBhY2thZ2UKICAgICAgdHlwZTogaW5mbwo=")
 		assets["normalizationData"] = value
 	}
 
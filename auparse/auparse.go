@@ -19,13 +19,19 @@ package auparse
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"golang.org/x/sys/unix"
@@ -39,6 +45,11 @@ import (
 const (
 	typeToken = "type="
 	msgToken  = "msg="
+
+	// enrichedSeparator is the ASCII group separator that auditd places
+	// between the raw fields and the interpreted fields in the ENRICHED
+	// log format.
+	enrichedSeparator = '\x1d'
 )
 
 var (
@@ -72,6 +83,13 @@ type AuditMessage struct {
 	offset int               // offset is the index into RawData where the header ends and message begins.
 	tags   []string          // The keys associated with the event (e.g. the values set in rules with -F key=exec).
 	error  error             // Error that occurred while parsing.
+	parsed bool              // parsed is true once the data has been parsed (successfully or not).
+	parser *Parser           // Parser whose options were used to parse the data (nil for none).
+
+	redacted      map[string]struct{} // Keys that were changed or dropped by a Redactor.
+	itemsRedacted bool                // A Redactor changed an embedded item.
+
+	enrichErrors []error // Non-fatal errors that occurred while enriching the data.
 }
 
 type Field struct {
@@ -84,22 +102,53 @@ func (f *Field) Orig() string     { return f.orig }
 func (f *Field) Value() string    { return f.value }
 func (f *Field) Set(value string) { f.value = value }
 
+// quoted reports whether the original value was quoted. The kernel logs hex
+// values unquoted, so a quoted value is never hex even if it looks like it.
+func (f *Field) quoted() bool {
+	return len(f.orig) > 0 && (f.orig[0] == '"' || f.orig[0] == '\'')
+}
+
 // Data returns the key-value pairs that are contained in the audit message.
 // This information is parsed from the raw message text the first time this
 // method is called, all future invocations return the stored result. A nil
 // map may be returned error is non-nil. A non-nil error is returned if there
-// was a failure parsing or enriching the data.
+// was a failure parsing the data. Failures to enrich individual fields are
+// reported by EnrichmentErrors.
 func (m *AuditMessage) Data() (map[string]string, error) {
-	if m.data != nil || m.error != nil {
+	if m.parsed {
 		return m.data, m.error
 	}
-	return m.DataB(map[string]Field{}, map[string]string{})
+	data := m.data
+	if data == nil {
+		data = map[string]string{}
+	}
+	return m.DataB(map[string]Field{}, data)
+}
+
+// Reset clears m so that it can be reused, for example from a sync.Pool.
+// The map previously returned by Data is emptied and reused by the next parse
+// of m, so callers must not retain it (or copies of m) after calling Reset.
+func (m *AuditMessage) Reset() {
+	data := m.data
+	for k := range data {
+		delete(data, k)
+	}
+	*m = AuditMessage{data: data}
 }
 
 func (m *AuditMessage) DataB(fields map[string]Field, data map[string]string) (map[string]string, error) {
-	if m.data != nil || m.error != nil {
+	return m.parseData(fields, data, nil)
+}
+
+// parseData parses and enriches the message using the given maps as storage.
+// The Parser p is optional and controls enrichment and filtering.
+func (m *AuditMessage) parseData(fields map[string]Field, data map[string]string, p *Parser) (map[string]string, error) {
+	if m.parsed {
 		return m.data, m.error
 	}
+	m.parsed = true
+	m.parser = p
+	m.data = nil
 
 	for k := range fields {
 		delete(fields, k)
@@ -120,9 +169,10 @@ func (m *AuditMessage) DataB(fields map[string]Field, data map[string]string) (m
 	defer func() { m.fields = nil }()
 	extractKeyValuePairs(message, m.fields)
 
-	if err = enrichData(m); err != nil {
-		m.error = err
-		return nil, m.error
+	raw := p.rawValues(m.fields)
+	enrichData(m, p)
+	if raw != nil {
+		p.countUnknownKeys(raw, m.fields)
 	}
 
 	for k := range data {
@@ -130,22 +180,234 @@ func (m *AuditMessage) DataB(fields map[string]Field, data map[string]string) (m
 	}
 	m.data = data
 	for k, f := range m.fields {
+		if p != nil && !p.keep(k) {
+			continue
+		}
 		m.data[k] = f.Value()
 	}
+	m.redacted = redact(m.data)
 
 	return m.data, m.error
 }
 
+// EnrichmentErrors returns the errors that occurred while enriching the data
+// of the message (e.g. a malformed arch or hex value). These errors do not
+// cause Data to fail; the affected fields are returned in their raw form.
+func (m *AuditMessage) EnrichmentErrors() []error {
+	m.Data()
+	return m.enrichErrors
+}
+
+// KV is a key-value pair of an audit message.
+type KV struct {
+	Key   string
+	Value string
+}
+
+// OrderedFields returns the key-value pairs of the message in the order that
+// the keys appear in the raw message. Keys that were added during enrichment
+// (e.g. result) follow in sorted order. The values are the same as those
+// returned by Data.
+func (m *AuditMessage) OrderedFields() ([]KV, error) {
+	data, err := m.Data()
+	if err != nil {
+		return nil, err
+	}
+	message, err := normalizeAuditMessage(m.RecordType, m.RawData[m.offset:])
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]KV, 0, len(data))
+	seen := make(map[string]struct{}, len(data))
+	add := func(key string) {
+		value, found := data[key]
+		if _, dup := seen[key]; !found || dup {
+			return
+		}
+		seen[key] = struct{}{}
+		fields = append(fields, KV{Key: key, Value: value})
+	}
+	scanKeyValuePairs(message, func(key, _, _ string) { add(key) })
+
+	added := make([]string, 0, len(data)-len(seen))
+	for k := range data {
+		if _, found := seen[k]; !found {
+			added = append(added, k)
+		}
+	}
+	sort.Strings(added)
+	for _, k := range added {
+		add(k)
+	}
+	return fields, nil
+}
+
+// RawBytes returns the bytes of a field that was hex encoded in the raw
+// message (e.g. TTY data or proctitle) exactly as they were logged. Unlike
+// Data, NUL bytes are not replaced and invalid UTF-8 is kept as is. false is
+// returned if the key is not found, if its value was not hex decoded, or if a
+// Redactor changed or dropped it.
+func (m *AuditMessage) RawBytes(key string) ([]byte, bool) {
+	data, err := m.Data()
+	if err != nil {
+		return nil, false
+	}
+	value, found := data[key]
+	if _, redacted := m.redacted[key]; !found || redacted {
+		return nil, false
+	}
+	message, err := normalizeAuditMessage(m.RecordType, m.RawData[m.offset:])
+	if err != nil {
+		return nil, false
+	}
+
+	var raw *Field
+	scanKeyValuePairs(message, func(k, origValue, v string) {
+		if k == key && raw == nil {
+			raw = &Field{orig: origValue, value: v}
+		}
+	})
+	if raw == nil || raw.quoted() || raw.Orig() == value {
+		return nil, false
+	}
+
+	b, err := hex.DecodeString(raw.Orig())
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
 func (m *AuditMessage) Tags() ([]string, error) {
 	_, err := m.Data()
 	return m.tags, err
 }
 
+// SessionKey returns an identifier for the login session of the message that
+// is made of the auid and ses values (e.g. "1000:3"). All messages from the
+// same login session have the same key. "unset" is returned if the message
+// has no auid or ses or if either of them is unset.
+func (m *AuditMessage) SessionKey() (string, error) {
+	data, err := m.Data()
+	if err != nil {
+		return "", err
+	}
+
+	auid, ses := data["auid"], data["ses"]
+	if auid == "" || auid == "unset" || ses == "" || ses == "unset" {
+		return "unset", nil
+	}
+	return auid + ":" + ses, nil
+}
+
+// SortKey returns a key that orders messages by time and then by sequence
+// number. The timestamp is truncated to seconds and stored in the upper 32
+// bits while the sequence number is stored in the lower 32 bits, so messages
+// logged within the same second (or millisecond) sort deterministically by
+// their sequence number.
+func (m *AuditMessage) SortKey() uint64 {
+	return uint64(m.Timestamp.Unix())<<32 | uint64(m.Sequence)
+}
+
+// Execve returns the arguments (argv) of an EXECVE message. Hex encoded
+// arguments are decoded while quoted arguments are returned as is. An error is
+// returned if the message is not an EXECVE record or if an argument is
+// missing.
+func (m *AuditMessage) Execve() ([]string, error) {
+	if m.RecordType != AUDIT_EXECVE {
+		return nil, errors.Errorf("message type is %v, not EXECVE", m.RecordType)
+	}
+
+	data, err := m.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.ParseUint(data["argc"], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert argc='%v' to number", data["argc"])
+	}
+
+	argv := make([]string, 0, count)
+	for i := 0; i < int(count); i++ {
+		key := "a" + strconv.Itoa(i)
+		arg, found := data[key]
+		if !found {
+			return nil, errors.Errorf("failed to find arg %v", key)
+		}
+		argv = append(argv, arg)
+	}
+	return argv, nil
+}
+
+// Exit returns the exit code of the syscall of the message. A negative code
+// is returned with the name of its errno (e.g. -13 and EACCES) if it is known.
+// ok is false if the message has no exit field. A non-nil error is returned if
+// the data could not be parsed or the exit value is malformed.
+func (m *AuditMessage) Exit() (code int, errno string, ok bool, err error) {
+	data, err := m.Data()
+	if err != nil {
+		return 0, "", false, err
+	}
+
+	value, found := data["exit"]
+	if !found {
+		return 0, "", false, nil
+	}
+
+	// The enrichment replaces known errnos with their names.
+	if num, found := AuditErrnoToNum[value]; found {
+		return -num, value, true, nil
+	}
+
+	code, err = strconv.Atoi(value)
+	if err != nil {
+		return 0, "", false, errors.Wrap(err, "failed to parse exit")
+	}
+	return code, "", true, nil
+}
+
+// IsDenied returns true if the message records a denied or failed action.
+// This is the case for messages with result=fail, AVC denials
+// (seresult=denied), and SECCOMP records for a syscall whose process or
+// thread was killed. A non-nil error is returned if the data could not be
+// parsed.
+func (m *AuditMessage) IsDenied() (bool, error) {
+	data, err := m.Data()
+	if err != nil {
+		return false, err
+	}
+
+	if data["result"] == "fail" || data["seresult"] == "denied" {
+		return true, nil
+	}
+
+	if m.RecordType == AUDIT_SECCOMP {
+		code, err := strconv.ParseUint(strings.TrimPrefix(data["code"], "0x"), 16, 32)
+		if err != nil {
+			return false, nil
+		}
+		switch code & seccompRetActionFull {
+		case seccompRetKillThread, seccompRetKillProcess:
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Seccomp filter return actions from include/uapi/linux/seccomp.h.
+const (
+	seccompRetActionFull  = 0xffff0000
+	seccompRetKillProcess = 0x80000000
+	seccompRetKillThread  = 0x00000000
+)
+
 // ToMapStr returns a new map containing the parsed key value pairs, the
 // record_type, @timestamp, and sequence. The parsed key value pairs have
 // a lower precedence than the well-known keys and will not override them.
 // If an error occurred while parsing the message then an error key will be
-// present.
+// present. raw_msg is omitted if a Redactor changed or dropped a field.
 func (m *AuditMessage) ToMapStr() map[string]interface{} {
 	// Ensure event has been parsed.
 	data, err := m.Data()
@@ -158,7 +420,13 @@ func (m *AuditMessage) ToMapStr() map[string]interface{} {
 	out["record_type"] = m.RecordType.String()
 	out["@timestamp"] = m.Timestamp.UTC().String()
 	out["sequence"] = strconv.FormatUint(uint64(m.Sequence), 10)
-	out["raw_msg"] = m.RawData
+	if !m.rawRedacted() {
+		raw := map[string]string{"raw_msg": m.RawData}
+		redact(raw)
+		if v, found := raw["raw_msg"]; found {
+			out["raw_msg"] = v
+		}
+	}
 	if len(m.tags) > 0 {
 		out["tags"] = m.tags
 	}
@@ -168,30 +436,141 @@ func (m *AuditMessage) ToMapStr() map[string]interface{} {
 	return out
 }
 
+// rawRedacted reports whether a Redactor changed or dropped a field of the
+// message, including its embedded items. The raw message would then reveal
+// the redacted values.
+func (m *AuditMessage) rawRedacted() bool {
+	if len(m.redacted) > 0 {
+		return true
+	}
+	if m.RecordType == AUDIT_SYSCALL && hasRedactors() {
+		m.EmbeddedItems()
+	}
+	return m.itemsRedacted
+}
+
+// Equal returns true if m and other have the same record type, timestamp,
+// sequence number, data, and tags. The data is compared after it is parsed and
+// enriched, so messages that only differ in formatting (e.g. whitespace or
+// quoting) are equal. Messages whose data cannot be parsed are not equal.
+func (m *AuditMessage) Equal(other AuditMessage) bool {
+	if m.RecordType != other.RecordType || m.Sequence != other.Sequence || !m.Timestamp.Equal(other.Timestamp) {
+		return false
+	}
+
+	data, err := m.Data()
+	if err != nil {
+		return false
+	}
+	otherData, err := other.Data()
+	if err != nil || len(data) != len(otherData) || len(m.tags) != len(other.tags) {
+		return false
+	}
+	for i, tag := range m.tags {
+		if other.tags[i] != tag {
+			return false
+		}
+	}
+	for k, v := range data {
+		if ov, found := otherData[k]; !found || ov != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Fingerprint returns a hash of the record type, the parsed key value pairs,
+// and the tags of the message that can be used to detect duplicate messages.
+// Keys given in exclude (e.g. volatile fields like pid) are not part of the
+// hash. The timestamp and sequence are never included. If the data cannot be
+// parsed then the raw message is hashed instead.
+func (m *AuditMessage) Fingerprint(exclude ...string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte{byte(m.RecordType), byte(m.RecordType >> 8)})
+
+	data, err := m.Data()
+	if err != nil {
+		io.WriteString(h, m.RawData)
+		return h.Sum64()
+	}
+
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		if !containsString(exclude, k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		io.WriteString(h, k)
+		h.Write([]byte{0})
+		io.WriteString(h, data[k])
+		h.Write([]byte{0})
+	}
+	for _, tag := range m.tags {
+		io.WriteString(h, tag)
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// EncodeNDJSON writes the given messages to w as newline-delimited JSON. Each
+// line contains the output of ToMapStr for one message. Messages are written
+// as they are encoded so the full output is never held in memory.
+func EncodeNDJSON(w io.Writer, msgs []AuditMessage) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for i := range msgs {
+		if err := enc.Encode(msgs[i].ToMapStr()); err != nil {
+			return errors.Wrapf(err, "failed to encode message %d", i)
+		}
+	}
+	return nil
+}
+
 // ParseLogLine parses an audit message as logged by the Linux audit daemon.
 // It expects logs line that begin with the message type. For example,
 // "type=SYSCALL msg=audit(1488862769.030:19469538)". A non-nil error is
 // returned if it fails to parse the message header (type, timestamp, sequence).
 func ParseLogLine(line string) (AuditMessage, error) {
+	typ, msg, err := parseLogLineType(line)
+	if err != nil {
+		return AuditMessage{}, err
+	}
+	return Parse(typ, msg)
+}
+
+// parseLogLineType returns the record type of a log line and the message that
+// follows msg=, without parsing the message.
+func parseLogLineType(line string) (AuditMessageType, string, error) {
 	msgIndex := strings.Index(line, msgToken)
 	if msgIndex == -1 {
-		return AuditMessage{}, errInvalidAuditHeader
+		return 0, "", errInvalidAuditHeader
 	}
 
 	// Verify type=XXX is before msg=
 	if msgIndex < len(typeToken)+1 {
-		return AuditMessage{}, errInvalidAuditHeader
+		return 0, "", errInvalidAuditHeader
 	}
 
 	// Convert the type to a number (i.e. type=SYSCALL -> 1300).
 	typName := line[len(typeToken) : msgIndex-1]
 	typ, err := GetAuditMessageType(typName)
 	if err != nil {
-		return AuditMessage{}, err
+		return 0, "", err
 	}
 
-	msg := line[msgIndex+len(msgToken):]
-	return Parse(typ, msg)
+	return typ, line[msgIndex+len(msgToken):], nil
 }
 
 // Parse parses an audit message in the format it was received from the kernel.
@@ -210,15 +589,35 @@ func Parse(typ AuditMessageType, message string) (AuditMessage, error) {
 		return AuditMessage{}, err
 	}
 
+	// The message begins after the ':' or ' ' that follows the header.
+	offset := indexOfMessage(message[end:])
+	if offset != -1 {
+		offset += end + 1
+	}
+
 	return AuditMessage{
 		RecordType: typ,
 		Timestamp:  timestamp,
 		Sequence:   seq,
-		offset:     indexOfMessage(message[end:]),
+		offset:     offset,
 		RawData:    message,
 	}, nil
 }
 
+// ParseInto is like Parse but stores the result in m, which is Reset first.
+// It allows an AuditMessage to be reused across many messages without
+// allocating a new data map for each one. m is left reset on error.
+func ParseInto(m *AuditMessage, typ AuditMessageType, message string) error {
+	m.Reset()
+	msg, err := Parse(typ, message)
+	if err != nil {
+		return err
+	}
+	msg.data = m.data
+	*m = msg
+	return nil
+}
+
 // parseAuditHeader parses the timestamp and sequence number from the audit
 // message header that has the form of "audit(1490137971.011:50406):".
 func parseAuditHeader(line string) (time.Time, uint32, int, error) {
@@ -281,6 +680,11 @@ var (
 	// the seresult and seperms parameters.
 	// Example: "avc:  denied  { read } for  "
 	selinuxAVCMessageRegex = regexp.MustCompile(`avc:\s+(\w+)\s+\{\s*(.*)\s*\}\s+for\s+`)
+
+	// selinuxErrMessageRegex matches the free text at the beginning of
+	// SELinux errors that are logged by older kernels.
+	// Example: "security_compute_sid:  invalid context X for "
+	selinuxErrMessageRegex = regexp.MustCompile(`^\s*(security_\w+):\s+invalid context (\S+) for\s+`)
 )
 
 // normalizeAuditMessage fixes some of the peculiarities of certain audit
@@ -301,16 +705,47 @@ func normalizeAuditMessage(typ AuditMessageType, msg string) (string, error) {
 		}
 		perms := strings.Fields(msg[i[4]:i[5]])
 		msg = fmt.Sprintf("seresult=%v seperms=%v %v", msg[i[2]:i[3]], strings.Join(perms, ","), msg[i[1]:])
+	case AUDIT_SELINUX_ERR:
+		// Convert the free text form to an op like the one used by newer
+		// kernels (e.g. op=security_compute_sid).
+		if m := selinuxErrMessageRegex.FindStringSubmatch(msg); m != nil {
+			msg = fmt.Sprintf(`op=%v reason="invalid context" invalid_context=%v %v`, m[1], m[2], msg[len(m[0]):])
+		}
 	case AUDIT_LOGIN:
 		msg = strings.Replace(msg, "old ", "old_", 2)
 		msg = strings.Replace(msg, "new ", "new_", 2)
+	case AUDIT_SYSCALL:
+		// Embedded PATH items are returned by EmbeddedItems.
+		msg, _ = splitEmbeddedItems(msg)
+	case AUDIT_DAEMON_START, AUDIT_DAEMON_END, AUDIT_DAEMON_ABORT,
+		AUDIT_DAEMON_CONFIG, AUDIT_DAEMON_RECONFIG, AUDIT_DAEMON_ROTATE,
+		AUDIT_DAEMON_RESUME, AUDIT_DAEMON_ERR:
+		msg = normalizeDaemonOp(msg)
 	}
 
 	return msg, nil
 }
 
+// normalizeDaemonOp converts the operation that older versions of auditd
+// write as free text at the start of their lifecycle records (e.g. "auditd
+// start, ver=2.8.5 ...") to an op field like the one used by newer versions.
+func normalizeDaemonOp(msg string) string {
+	msg = strings.TrimLeft(msg, " ")
+	if !strings.HasPrefix(msg, "auditd ") {
+		return msg
+	}
+	end := strings.IndexByte(msg, ',')
+	if end == -1 || strings.IndexByte(msg[:end], '=') != -1 {
+		return msg
+	}
+	return `op="` + msg[len("auditd "):end] + `"` + msg[end+1:]
+}
+
+// isKeyRune reports whether r can be part of a key. Uppercase letters are
+// accepted because the ENRICHED log format appends interpreted values using
+// uppercase keys (e.g. UID="root").
 func isKeyRune(r rune) bool {
-	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-'
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-'
 }
 
 func isInterestingValue(v string) bool {
@@ -322,15 +757,49 @@ func isInterestingValue(v string) bool {
 	}
 }
 
-func saveKeyValue(key, origValue, value string, data map[string]Field) {
+func saveKeyValue(key, origValue, value string, save func(key, origValue, value string)) {
 	if key == "msg" {
-		extractKeyValuePairs(value, data)
+		header, payload := splitAuditHeader(value)
+		if header != "" {
+			save("msg_header", header, header)
+		}
+		scanKeyValuePairs(payload, save)
 	} else if isInterestingValue(value) {
-		data[key] = Field{origValue, value}
+		save(key, origValue, value)
+	}
+}
+
+// trimAuditHeader removes a leading "audit(1490137971.011:50406):" header
+// from a nested msg payload so that it is not parsed as key-value pairs.
+func trimAuditHeader(msg string) string {
+	_, payload := splitAuditHeader(msg)
+	return payload
+}
+
+// splitAuditHeader splits a nested msg payload into the timestamp and
+// sequence of its leading "audit(1490137971.011:50406):" header (e.g.
+// "1490137971.011:50406") and the rest of the payload. The header is empty if
+// the payload does not begin with a valid header.
+func splitAuditHeader(msg string) (header, payload string) {
+	if !strings.HasPrefix(msg, "audit(") {
+		return "", msg
+	}
+	_, _, end, err := parseAuditHeader(msg)
+	if err != nil {
+		return "", msg
 	}
+	return msg[len("audit("):end], strings.TrimLeft(msg[end+1:], ": ")
 }
 
 func extractKeyValuePairs(msg string, data map[string]Field) {
+	scanKeyValuePairs(msg, func(key, origValue, value string) {
+		data[key] = Field{origValue, value}
+	})
+}
+
+// scanKeyValuePairs calls save for each key-value pair of msg in the order
+// that they appear.
+func scanKeyValuePairs(msg string, save func(key, origValue, value string)) {
 	type parseState int
 	const (
 		skipState parseState = iota
@@ -372,95 +841,203 @@ func extractKeyValuePairs(msg string, data map[string]Field) {
 			state = plainValueState
 			fallthrough
 		case plainValueState:
-			if r != '\'' && r != '"' && !unicode.IsSpace(r) {
+			// An unquoted value ends at whitespace or a quote. Any '=' it
+			// contains (e.g. opts=a=b) is part of the value and does not
+			// begin a new key.
+			if r != '\'' && r != '"' && r != enrichedSeparator && !unicode.IsSpace(r) {
 				continue
 			}
 			v := msg[valueStart:i]
-			saveKeyValue(key, v, v, data)
+			saveKeyValue(key, v, v, save)
 			state = skipState
 		case quotedValueState:
 			if r == quote && !backslash {
 				v := msg[valueStart+1 : i]
-				saveKeyValue(key, msg[valueStart:i+1], v, data)
+				saveKeyValue(key, msg[valueStart:i+1], v, save)
 				state = skipState
 			}
 			backslash = r == '\\'
 		}
 	}
-	// at the end of the loop the only "valid" state that needs processing
-	// is plainValueState. everything else can be ignored.
-	if state == plainValueState {
+	// at the end of the loop the only "valid" states that need processing
+	// are plainValueState and an unterminated msg='... payload (the closing
+	// quote is sometimes lost). everything else can be ignored.
+	switch {
+	case state == plainValueState:
 		v := msg[valueStart:]
-		saveKeyValue(key, v, v, data)
+		saveKeyValue(key, v, v, save)
+	case state == quotedValueState && key == "msg":
+		v := msg[valueStart+1:]
+		saveKeyValue(key, msg[valueStart:], v, save)
 	}
 }
 
 // Enrichment after KV parsing
 
-func enrichData(msg *AuditMessage) error {
-	normalizeUnsetID("auid", msg.fields)
-	normalizeUnsetID("old-auid", msg.fields)
-	normalizeUnsetID("ses", msg.fields)
+// enrichData decodes and normalizes the fields of msg. A failure to enrich one
+// field does not prevent the others from being enriched. Such failures are
+// recorded in msg and returned by EnrichmentErrors.
+func enrichData(msg *AuditMessage, p *Parser) {
+	for _, key := range unsetIDKeys {
+		if p.rawIDsEnabled() {
+			preserveRawValue(key, msg.fields)
+		}
+		normalizeUnsetID(key, msg.fields)
+	}
+
+	normalizeTTY(msg.fields)
 
 	// Many different message types can have subj field so check them all.
-	parseSELinuxContext("subj", msg.fields)
+	parseSubjectContext(msg.fields)
 
 	// Normalize success/res to result.
+	if p.resultInverted(msg.RecordType) {
+		invertNumericResult(msg.fields)
+	}
 	result(msg.fields)
 
 	// Convert exit codes to named POSIX exit codes.
 	exit(msg.fields)
 
+	if p.resultDetailEnabled() {
+		resultDetail(msg.fields)
+	}
+
 	// Normalize keys that are of the form key="key=user_command".
 	auditRuleKey(msg)
 
-	hexDecode("cwd", msg.fields)
+	hexDecode("cwd", msg.fields, p.nulMode("cwd"))
 
 	switch msg.RecordType {
 	case AUDIT_SECCOMP:
-		if err := setSignalName(msg.fields); err != nil {
-			return err
-		}
+		msg.enrichmentError(setSignalName(msg.fields))
 		fallthrough
 	case AUDIT_SYSCALL:
-		if err := arch(msg.fields); err != nil {
-			return err
+		if _, found := msg.fields["arch"]; !found && p.defaultArch() != 0 {
+			msg.fields["arch"] = newField(strconv.FormatUint(uint64(p.defaultArch()), 16))
 		}
-		if err := setSyscallName(msg.fields); err != nil {
-			return err
+		// ENRICHED logs contain the values that were resolved by auditd.
+		if !useEnrichedValue("arch", "ARCH", msg.fields) {
+			msg.enrichmentError(arch(msg.fields))
 		}
-		if err := hexDecode("exe", msg.fields); err != nil {
-			return errors.WithMessage(err, "exe")
+		if !useEnrichedValue("syscall", "SYSCALL", msg.fields) {
+			msg.enrichmentError(setSyscallName(msg.fields, p.syscalls()))
 		}
-	case AUDIT_SOCKADDR:
-		if err := saddr(msg.fields); err != nil {
-			return err
+		syscallArgs(msg.fields)
+		if err := hexDecode("exe", msg.fields, p.nulMode("exe")); err != nil {
+			msg.enrichmentError(errors.WithMessage(err, "exe"))
 		}
+	case AUDIT_SOCKADDR:
+		msg.enrichmentError(saddr(msg.fields, p.expandedAddresses()))
 	case AUDIT_PROCTITLE:
-		if err := hexDecode("proctitle", msg.fields); err != nil {
-			return errors.WithMessage(err, "proctitle")
+		if err := hexDecode("proctitle", msg.fields, p.nulMode("proctitle")); err != nil {
+			msg.enrichmentError(errors.WithMessage(err, "proctitle"))
 		}
 	case AUDIT_USER_CMD:
-		if err := hexDecode("cmd", msg.fields); err != nil {
-			return errors.WithMessage(err, "cmd")
+		if err := hexDecode("cmd", msg.fields, p.nulMode("cmd")); err != nil {
+			msg.enrichmentError(errors.WithMessage(err, "cmd"))
 		}
 	case AUDIT_TTY, AUDIT_USER_TTY:
-		if err := hexDecode("data", msg.fields); err != nil {
-			return errors.WithMessage(err, "data")
+		if err := hexDecode("data", msg.fields, p.nulMode("data")); err != nil {
+			msg.enrichmentError(errors.WithMessage(err, "data"))
 		}
 	case AUDIT_EXECVE:
-		if err := execveArgs(msg.fields); err != nil {
-			return err
-		}
+		msg.enrichmentError(execveArgs(msg.fields))
 	case AUDIT_PATH:
+		enrichPath(msg.fields, p)
+	case AUDIT_BPRM_FCAPS:
+		setCapabilityVersion("fver", msg.fields)
+		setCapabilityMasks(bprmFcapsCapabilityKeys, msg.fields, p)
+	case AUDIT_CAPSET:
+		setCapabilityMasks(capsetCapabilityKeys, msg.fields, p)
+	case AUDIT_AVC:
+		setCapabilityName("capability", msg.fields)
+		// subj is parsed for all record types above.
 		parseSELinuxContext("obj", msg.fields)
-		hexDecode("name", msg.fields)
+		splitSELinuxContext("scontext", msg.fields)
+		splitSELinuxContext("tcontext", msg.fields)
+	case AUDIT_SELINUX_ERR:
+		splitSELinuxContext("scontext", msg.fields)
+		splitSELinuxContext("tcontext", msg.fields)
+		splitSELinuxContext("oldcontext", msg.fields)
+		splitSELinuxContext("newcontext", msg.fields)
+	case AUDIT_CONFIG_CHANGE:
+		configChangeOp(msg.fields)
+		configChangeTunable(msg.fields)
+	case AUDIT_MAC_IPSEC_ADDSA, AUDIT_MAC_IPSEC_DELSA, AUDIT_MAC_IPSEC_ADDSPD,
+		AUDIT_MAC_IPSEC_DELSPD, AUDIT_MAC_IPSEC_EVENT, AUDIT_CRYPTO_IPSEC_SA:
+		xfrmDirection("dir", msg.fields)
+		xfrmDirection("direction", msg.fields)
+	case AUDIT_OBJ_PID:
+		// The target of a signal (e.g. kill) that is logged in the SYSCALL.
+		normalizeUnsetID("oauid", msg.fields)
+		normalizeUnsetID("oses", msg.fields)
+		parseSELinuxContext("obj", msg.fields)
+		hexDecode("ocomm", msg.fields, p.nulMode("ocomm"))
+	case AUDIT_IPC, AUDIT_IPC_SET_PERM:
+		normalizeUnsetID("ouid", msg.fields)
+		normalizeUnsetID("ogid", msg.fields)
+		ipcPermissions(msg.fields)
+	case AUDIT_FANOTIFY:
+		fanotifyResponse("resp", msg.fields)
+	case AUDIT_ANOM_PROMISCUOUS:
+		promiscuousMode("prom", msg.fields)
+		promiscuousMode("old_prom", msg.fields)
 	case AUDIT_USER_LOGIN:
 		// acct only exists in failed logins.
-		hexDecode("acct", msg.fields)
+		hexDecode("acct", msg.fields, p.nulMode("acct"))
+	case AUDIT_USER_MGMT, AUDIT_GRP_MGMT:
+		// id is the uid or gid of the managed account. acct and grp are hex
+		// encoded when they contain special characters.
+		normalizeUnsetID("id", msg.fields)
+		hexDecode("acct", msg.fields, p.nulMode("acct"))
+		hexDecode("grp", msg.fields, p.nulMode("grp"))
 	}
 
-	return nil
+	if isUserMessageType(msg.RecordType) {
+		pamOperation(msg.fields)
+	}
+
+	if p.latin1PathsEnabled() {
+		for _, key := range []string{"cwd", "exe", "name"} {
+			latin1Path(key, msg.fields)
+		}
+	}
+}
+
+// enrichPath enriches the fields of a PATH record or of a PATH item that is
+// embedded in a SYSCALL record.
+func enrichPath(fields map[string]Field, p *Parser) {
+	parseSELinuxContext("obj", fields)
+	hexDecode("name", fields, p.nulMode("name"))
+	setCapabilityVersion("cap_fver", fields)
+	setCapabilityMasks(pathCapabilityKeys, fields, p)
+}
+
+// enrichmentError records a non-fatal error that occurred while enriching
+// the message. nil errors are ignored.
+func (m *AuditMessage) enrichmentError(err error) {
+	if err != nil {
+		m.enrichErrors = append(m.enrichErrors, err)
+	}
+}
+
+// useEnrichedValue sets the value of key to the value of its resolved form in
+// the ENRICHED log format (e.g. ARCH for arch). It returns false if there is
+// no resolved value.
+func useEnrichedValue(key, enrichedKey string, data map[string]Field) bool {
+	enriched, found := data[enrichedKey]
+	if !found || !isInterestingValue(enriched.Value()) {
+		return false
+	}
+	field, found := data[key]
+	if !found {
+		return false
+	}
+
+	field.Set(enriched.Value())
+	data[key] = field
+	return true
 }
 
 func arch(data map[string]Field) error {
@@ -471,6 +1048,10 @@ func arch(data map[string]Field) error {
 
 	arch, err := strconv.ParseInt(field.Value(), 16, 64)
 	if err != nil {
+		if isArchName(field.Value()) {
+			// Already enriched (e.g. when re-parsing formatted output).
+			return nil
+		}
 		return errors.Wrap(err, "failed to parse arch")
 	}
 
@@ -479,7 +1060,7 @@ func arch(data map[string]Field) error {
 	return nil
 }
 
-func setSyscallName(data map[string]Field) error {
+func setSyscallName(data map[string]Field, resolver SyscallResolver) error {
 	field, found := data["syscall"]
 	if !found {
 		return errSyscallKeyNotFound
@@ -487,6 +1068,10 @@ func setSyscallName(data map[string]Field) error {
 
 	syscall, err := strconv.Atoi(field.Value())
 	if err != nil {
+		if isSyscallName(field.Value()) {
+			// Already enriched (e.g. when re-parsing formatted output).
+			return nil
+		}
 		return errors.Wrap(err, "failed to parse syscall")
 	}
 
@@ -495,13 +1080,164 @@ func setSyscallName(data map[string]Field) error {
 		return errArchKeyNotFoundInSyscall
 	}
 
-	if name, found := AuditSyscalls[arch.Value()][syscall]; found {
+	if resolver != nil {
+		if name, found := resolver.SyscallName(arch.Value(), syscall); found {
+			field.Set(name)
+			data["syscall"] = field
+			return nil
+		}
+	}
+
+	table := syscallTable(arch)
+	if syscall&x32SyscallBit != 0 && arch.Value() == "x86_64" {
+		// x32 ABI syscalls use the x86_64 arch with the x32 bit set.
+		data["abi"] = newField("x32")
+		syscall &^= x32SyscallBit
+		if name, found := x32Syscalls[syscall]; found {
+			field.Set(name)
+			data["syscall"] = field
+			return nil
+		}
+	}
+
+	if name, found := table[syscall]; found {
 		field.Set(name)
 		data["syscall"] = field
 	}
 	return nil
 }
 
+// isArchName reports whether s is the name of an architecture as returned by
+// AuditArch.String, including names of unknown architectures.
+func isArchName(s string) bool {
+	if strings.HasPrefix(s, "unknown[") {
+		return true
+	}
+	for _, name := range AuditArchNames {
+		if name == s {
+			return true
+		}
+	}
+	return false
+}
+
+// isSyscallName reports whether s looks like a syscall name rather than a
+// number. Names are not looked up because they may come from a
+// SyscallResolver.
+func isSyscallName(s string) bool {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// x32SyscallBit is set in the syscall numbers of the x32 ABI
+// (__X32_SYSCALL_BIT).
+const x32SyscallBit = 0x40000000
+
+// x32Syscalls contains the x32 syscalls that differ from x86_64, as defined
+// in arch/x86/entry/syscalls/syscall_64.tbl. All other x32 syscalls share
+// their number with x86_64.
+var x32Syscalls = map[int]string{
+	512: "rt_sigaction",
+	513: "rt_sigreturn",
+	514: "ioctl",
+	515: "readv",
+	516: "writev",
+	517: "recvfrom",
+	518: "sendmsg",
+	519: "recvmsg",
+	520: "execve",
+	521: "ptrace",
+	522: "rt_sigpending",
+	523: "rt_sigtimedwait",
+	524: "rt_sigqueueinfo",
+	525: "sigaltstack",
+	526: "timer_create",
+	527: "mq_notify",
+	528: "kexec_load",
+	529: "waitid",
+	530: "set_robust_list",
+	531: "get_robust_list",
+	532: "vmsplice",
+	533: "move_pages",
+	534: "preadv",
+	535: "pwritev",
+	536: "rt_tgsigqueueinfo",
+	537: "recvmmsg",
+	538: "sendmmsg",
+	539: "process_vm_readv",
+	540: "process_vm_writev",
+	541: "setsockopt",
+	542: "getsockopt",
+	543: "io_setup",
+	544: "io_submit",
+	545: "execveat",
+	546: "preadv2",
+	547: "pwritev2",
+}
+
+// syscallTableAliases maps arch names that have no table of their own to the
+// table that they share syscall numbers with.
+var syscallTableAliases = map[string]string{
+	"armeb":   "arm",
+	"ppc64":   "ppc",
+	"ppc64le": "ppc",
+}
+
+// elfMachineSyscallTables maps the ELF machine number contained in the low
+// bits of an audit arch value to a syscall table.
+var elfMachineSyscallTables = map[uint32]string{
+	3:   "i386",    // EM_386
+	20:  "ppc",     // EM_PPC
+	21:  "ppc",     // EM_PPC64
+	22:  "s390",    // EM_S390 (s390x when 64-bit)
+	40:  "arm",     // EM_ARM
+	50:  "ia64",    // EM_IA_64
+	62:  "x86_64",  // EM_X86_64
+	183: "aarch64", // EM_AARCH64
+}
+
+// Flags that are combined with the ELF machine number to form an audit arch.
+const (
+	auditArch64Bit      = 0x80000000
+	auditArchLE         = 0x40000000
+	auditArchConvention = 0x30000000
+)
+
+// syscallTable returns the syscall table to use for the given arch field. It
+// first uses the enriched arch name, and if that does not have a table (e.g.
+// the arch could not be mapped to a name) then it uses the ELF machine
+// number from the raw arch value.
+func syscallTable(arch Field) map[int]string {
+	name := arch.Value()
+	if table, found := AuditSyscalls[name]; found {
+		return table
+	}
+	if alias, found := syscallTableAliases[name]; found {
+		return AuditSyscalls[alias]
+	}
+
+	raw, err := strconv.ParseUint(arch.Orig(), 16, 32)
+	if err != nil {
+		return nil
+	}
+	machine := uint32(raw) &^ (auditArch64Bit | auditArchLE | auditArchConvention)
+	name, found := elfMachineSyscallTables[machine]
+	if !found {
+		return nil
+	}
+	if name == "s390" && raw&auditArch64Bit != 0 {
+		name = "s390x"
+	}
+	return AuditSyscalls[name]
+}
+
 func setSignalName(data map[string]Field) error {
 	field, found := data["sig"]
 	if !found {
@@ -510,23 +1246,37 @@ func setSignalName(data map[string]Field) error {
 
 	signalNum, err := strconv.Atoi(field.Value())
 	if err != nil {
+		if unix.SignalNum(field.Value()) != 0 {
+			// Already enriched (e.g. when re-parsing formatted output).
+			return nil
+		}
 		return errors.Wrap(err, "failed to parse sig")
 	}
 
-	if signalName := unix.SignalName(syscall.Signal(signalNum)); signalName != "" {
+	if signalName := signalName(signalNum); signalName != "" {
 		field.Set(signalName)
 		data["sig"] = field
 	}
 	return nil
 }
 
-func saddr(data map[string]Field) error {
+// signalName returns the name of the signal number (e.g. SIGKILL) or an empty
+// string if it is unknown.
+func signalName(num int) string {
+	return unix.SignalName(syscall.Signal(num))
+}
+
+func saddr(data map[string]Field, expanded bool) error {
 	field, found := data["saddr"]
 	if !found {
+		if _, decoded := data["family"]; decoded {
+			// Already enriched (e.g. when re-parsing formatted output).
+			return nil
+		}
 		return errSaddrKeyNotFound
 	}
 
-	saddrData, err := parseSockaddr(field.Value())
+	saddrData, err := parseSockaddr(field.Value(), expanded)
 	if err != nil {
 		return errors.Wrap(err, "failed to parse saddr")
 	}
@@ -538,20 +1288,150 @@ func saddr(data map[string]Field) error {
 	return nil
 }
 
+// unsetIDKeys are the keys of IDs that can be unset.
+var unsetIDKeys = []string{"auid", "old-auid", "ses", "AUID", "OLD-AUID"}
+
+// preserveRawValue copies the value of key to key_raw.
+func preserveRawValue(key string, data map[string]Field) {
+	if field, found := data[key]; found {
+		data[key+"_raw"] = newField(field.Value())
+	}
+}
+
+// normalizeUnsetID replaces the values used for an unset ID with "unset". This
+// covers the raw numeric values and the textual forms that are found in
+// enriched or interpreted logs. Other values are never reformatted. The value
+// as it was logged remains available from Field.Orig and, if the Parser has
+// SetRawIDs enabled, from key_raw.
 func normalizeUnsetID(key string, data map[string]Field) {
 	field, found := data[key]
 	if !found {
 		return
 	}
 
-	switch field.Value() {
-	case "4294967295", "-1":
+	switch strings.ToLower(field.Value()) {
+	case "4294967295", "-1", "unset", "(unknown(4294967295))", "(unknown(-1))", "unknown(4294967295)":
 		field.Set("unset")
 		data[key] = field
 	}
 }
 
-func hexDecode(key string, data map[string]Field) error {
+// Device major numbers used for terminals (see Documentation/admin-guide/devices.txt).
+const (
+	ttyMajor      = 4   // Virtual consoles are minors 0-63.
+	ptsMajorFirst = 136 // Unix98 PTY slaves use majors 136-143.
+	ptsMajorLast  = 143
+)
+
+// normalizeTTY converts a tty given as a numeric device number into the
+// device name (e.g. 34818 -> pts2). Textual values are left unchanged.
+func normalizeTTY(data map[string]Field) {
+	field, found := data["tty"]
+	if !found {
+		return
+	}
+
+	dev, err := strconv.ParseUint(field.Value(), 10, 32)
+	if err != nil {
+		return
+	}
+
+	// Decode the kernel's dev_t encoding (see new_decode_dev).
+	major := (dev & 0xfff00) >> 8
+	minor := (dev & 0xff) | ((dev >> 12) & 0xfff00)
+
+	switch {
+	case major >= ptsMajorFirst && major <= ptsMajorLast:
+		field.Set("pts" + strconv.FormatUint((major-ptsMajorFirst)<<8|minor, 10))
+	case major == ttyMajor && minor < 64:
+		field.Set("tty" + strconv.FormatUint(minor, 10))
+	default:
+		return
+	}
+	data["tty"] = field
+}
+
+// ipcPermissions decodes the fields describing the permissions of a SysV IPC
+// object. The octal mode is written symbolically to perm (e.g. 0640 becomes
+// rw-r-----) and qbytes, which the kernel logs in hex, is converted to
+// decimal. A quoted qbytes has already been converted.
+func ipcPermissions(data map[string]Field) {
+	if field, found := data["mode"]; found {
+		if mode, err := strconv.ParseUint(field.Value(), 8, 32); err == nil {
+			data["perm"] = newField(os.FileMode(mode).Perm().String()[1:])
+		}
+	}
+
+	if field, found := data["qbytes"]; found && !field.quoted() {
+		if qbytes, err := strconv.ParseUint(field.Value(), 16, 64); err == nil {
+			field.Set(strconv.FormatUint(qbytes, 10))
+			data["qbytes"] = field
+		}
+	}
+}
+
+// promiscuousMode converts the interface flag value logged in
+// ANOM_PROMISCUOUS records to "on" or "off". The kernel logs either the
+// IFF_PROMISC flag (256) or 1 when promiscuous mode is enabled.
+func promiscuousMode(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found {
+		return
+	}
+
+	flags, err := strconv.ParseUint(field.Value(), 10, 32)
+	if err != nil {
+		return
+	}
+
+	if flags == 0 {
+		field.Set("off")
+	} else {
+		field.Set("on")
+	}
+	data[key] = field
+}
+
+// fanotifyResponse converts the permission event response logged in FANOTIFY
+// records (FAN_ALLOW or FAN_DENY) to "allow" or "deny".
+func fanotifyResponse(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found {
+		return
+	}
+
+	resp, err := strconv.ParseUint(field.Value(), 10, 32)
+	if err != nil {
+		return
+	}
+
+	// Mask FAN_AUDIT and FAN_INFO which only control how the response is
+	// reported.
+	switch resp &^ 0x30 {
+	case 0x01:
+		field.Set("allow")
+	case 0x02:
+		field.Set("deny")
+	default:
+		return
+	}
+	data[key] = field
+}
+
+// latin1Path converts the value of key from Latin-1 to UTF-8 if it is not
+// valid UTF-8. Valid UTF-8 values are left as is.
+func latin1Path(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found || utf8.ValidString(field.Value()) {
+		return
+	}
+	field.Set(latin1ToUTF8(field.Value()))
+	data[key] = field
+}
+
+// hexDecode decodes the hex encoded value of key. NUL bytes in the decoded
+// value are handled according to mode.
+func hexDecode(key string, data map[string]Field, mode NULMode) error {
 	field, found := data[key]
 	if !found {
 		return errHexEncodeKeyNotFound
@@ -559,6 +1439,10 @@ func hexDecode(key string, data map[string]Field) error {
 	if len(field.Orig()) == 0 || len(field.Orig())%2 == 1 {
 		return nil
 	}
+	// Some kernels log a quoted string instead (e.g. proctitle="cafe").
+	if field.quoted() {
+		return nil
+	}
 
 	src := field.Orig()
 	var dst strings.Builder
@@ -573,10 +1457,18 @@ func hexDecode(key string, data map[string]Field) error {
 			return nil
 		}
 		c := (a << 4) | b
-		if c == 0 {
-			c = ' '
+		if c != 0 {
+			dst.WriteByte(c)
+			continue
+		}
+		switch mode {
+		case NULSpace:
+			dst.WriteByte(' ')
+		case NULKeep:
+			dst.WriteByte(0)
+		case NULEscape:
+			dst.WriteString(`\x00`)
 		}
-		dst.WriteByte(c)
 	}
 
 	field.Set(dst.String())
@@ -612,15 +1504,83 @@ func execveArgs(data map[string]Field) error {
 	return nil
 }
 
+// parseSubjectContext parses the subj field according to the LSM that produced
+// it. Systems with stacked LSMs name the module in the lsm field. SELinux is
+// assumed when it is absent. Contexts of other LSMs are left as is.
+func parseSubjectContext(data map[string]Field) {
+	lsm := "selinux"
+	if field, found := data["lsm"]; found {
+		field.Set(strings.ToLower(field.Value()))
+		data["lsm"] = field
+		lsm = field.Value()
+	}
+
+	switch lsm {
+	case "selinux":
+		parseSELinuxContext("subj", data)
+	case "apparmor":
+		parseAppArmorLabel("subj", data)
+	}
+}
+
+// parseAppArmorLabel parses an AppArmor label of the form 'profile (mode)'
+// into its profile and mode. Labels without a mode (e.g. unconfined) only
+// have a profile.
+func parseAppArmorLabel(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found || !isInterestingValue(field.Value()) {
+		return
+	}
+	delete(data, key)
+
+	profile, mode := field.Value(), ""
+	if strings.HasSuffix(profile, ")") {
+		if idx := strings.LastIndex(profile, " ("); idx != -1 {
+			profile, mode = profile[:idx], profile[idx+2:len(profile)-1]
+		}
+	}
+
+	data[key+"_profile"] = newField(profile)
+	if mode != "" {
+		data[key+"_mode"] = newField(mode)
+	}
+}
+
 // parseSELinuxContext parses a SELinux security context of the form
-// 'user:role:domain:level:category'.
+// 'user:role:domain:level'. The level is an MLS range (e.g. s0-s0:c0.c1023)
+// that is kept intact and is also broken down into the sensitivity and
+// categories of its low and high levels (_sensitivity_low=s0,
+// _sensitivity_high=s0, and _categories_high=c0.c1023). The _high keys are
+// only set for a range. _category contains everything after the first colon
+// of the level, as it always has.
 func parseSELinuxContext(key string, data map[string]Field) error {
 	field, found := data[key]
 	if !found {
 		return errSELinuxKeyNotFound
 	}
 
-	keys := []string{"_user", "_role", "_domain", "_level", "_category"}
+	// Sentinels like '?' carry no context to split.
+	if !isInterestingValue(field.Value()) {
+		delete(data, key)
+		return nil
+	}
+
+	// The kernel hex-encodes a context that contains a space or other
+	// special character. Such a value is unquoted and has no ':'.
+	if v := field.Value(); field.Orig() == v && !strings.Contains(v, ":") {
+		if decoded, err := hexToString(v); err == nil && strings.Contains(decoded, ":") {
+			field.Set(decoded)
+		}
+	}
+
+	// Special labels like kernel or unlabeled are a single token that is kept
+	// as is.
+	if !strings.Contains(field.Value(), ":") {
+		data[key] = field
+		return nil
+	}
+
+	keys := []string{"_user", "_role", "_domain", "_level"}
 	contextParts := strings.SplitN(field.Value(), ":", len(keys))
 	if len(contextParts) == 0 {
 		return errSELinuxContextFieldSplit
@@ -628,11 +1588,77 @@ func parseSELinuxContext(key string, data map[string]Field) error {
 	delete(data, key)
 
 	for i, part := range contextParts {
+		if part == "" {
+			continue
+		}
 		data[key+keys[i]] = newField(part)
 	}
+
+	if len(contextParts) == len(keys) {
+		for i, level := range splitMLSRange(contextParts[3]) {
+			suffix := "_low"
+			if i == 1 {
+				suffix = "_high"
+			}
+			data[key+"_sensitivity"+suffix] = newField(level.sensitivity)
+			if level.categories != "" {
+				data[key+"_categories"+suffix] = newField(level.categories)
+			}
+		}
+		if idx := strings.IndexByte(contextParts[3], ':'); idx != -1 {
+			data[key+"_category"] = newField(contextParts[3][idx+1:])
+		}
+	}
 	return nil
 }
 
+// splitSELinuxContext adds the parts of the SELinux security context of key
+// like parseSELinuxContext does, but keeps key with the full (decoded)
+// context.
+func splitSELinuxContext(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found || !isInterestingValue(field.Value()) {
+		return
+	}
+	if err := parseSELinuxContext(key, data); err != nil {
+		return
+	}
+	if _, special := data[key]; special {
+		return
+	}
+
+	var parts []string
+	for _, part := range []string{"_user", "_role", "_domain", "_level"} {
+		if f, found := data[key+part]; found {
+			parts = append(parts, f.Value())
+		}
+	}
+	field.Set(strings.Join(parts, ":"))
+	data[key] = field
+}
+
+// mlsLevel is a level of an MLS range.
+type mlsLevel struct {
+	sensitivity string
+	categories  string
+}
+
+// splitMLSRange splits an MLS range of the form 'low[-high]', where each
+// level is 'sensitivity[:categories]', into its levels. For example
+// s0-s0:c0.c1023 returns {s0, ""} and {s0, c0.c1023}.
+func splitMLSRange(mls string) []mlsLevel {
+	var levels []mlsLevel
+	for _, level := range strings.SplitN(mls, "-", 2) {
+		parts := strings.SplitN(level, ":", 2)
+		l := mlsLevel{sensitivity: parts[0]}
+		if len(parts) == 2 {
+			l.categories = parts[1]
+		}
+		levels = append(levels, l)
+	}
+	return levels
+}
+
 func result(data map[string]Field) error {
 	// Syscall messages use "success". Other messages use "res".
 	field, found := data["success"]
@@ -647,7 +1673,7 @@ func result(data map[string]Field) error {
 	}
 
 	switch v := strings.ToLower(field.Value()); {
-	case v == "yes", v == "1", strings.HasPrefix(v, "suc"):
+	case v == "yes", v == "1", v == "true", strings.HasPrefix(v, "suc"):
 		data["result"] = newField("success")
 	default:
 		data["result"] = newField("fail")
@@ -655,6 +1681,159 @@ func result(data map[string]Field) error {
 	return nil
 }
 
+// invertNumericResult swaps a numeric res value of 0 and 1 for records that
+// use inverted semantics.
+func invertNumericResult(data map[string]Field) {
+	field, found := data["res"]
+	if !found {
+		return
+	}
+
+	switch field.Value() {
+	case "0":
+		field.Set("1")
+	case "1":
+		field.Set("0")
+	default:
+		return
+	}
+	data["res"] = field
+}
+
+// xfrmDirections are the names of the xfrm policy directions (XFRM_POLICY_IN,
+// XFRM_POLICY_OUT, XFRM_POLICY_FWD) as defined in include/uapi/linux/xfrm.h.
+var xfrmDirections = []string{"in", "out", "fwd"}
+
+// xfrmDirection converts a numeric IPsec policy direction to in, out, or fwd.
+// Textual directions are kept.
+func xfrmDirection(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found {
+		return
+	}
+
+	num, err := strconv.Atoi(field.Value())
+	if err != nil || num < 0 || num >= len(xfrmDirections) {
+		return
+	}
+	field.Set(xfrmDirections[num])
+	data[key] = field
+}
+
+// configChangeOps maps the spellings of rule operations in CONFIG_CHANGE
+// records, which vary across kernel versions, to a canonical verb.
+var configChangeOps = map[string]string{
+	"add":           "add",
+	"add_rule":      "add",
+	"add rule":      "add",
+	"remove":        "remove",
+	"remove_rule":   "remove",
+	"remove rule":   "remove",
+	"del":           "remove",
+	"del_rule":      "remove",
+	"delete_rule":   "remove",
+	"update":        "update",
+	"updated":       "update",
+	"update_rule":   "update",
+	"updated_rules": "update",
+	"update rule":   "update",
+}
+
+// configChangeOp adds the rule operation of a CONFIG_CHANGE record as add,
+// remove, or update to rule_op. op is left as it was logged. No rule_op is
+// added for other operations (e.g. set).
+func configChangeOp(data map[string]Field) {
+	field, found := data["op"]
+	if !found {
+		return
+	}
+
+	if op, found := configChangeOps[strings.ToLower(field.Value())]; found {
+		data["rule_op"] = newField(op)
+	}
+}
+
+// auditTunables are the kernel audit settings whose changes are logged as
+// <tunable>=<new value> old=<old value> in CONFIG_CHANGE records.
+var auditTunables = []string{
+	"audit_enabled",
+	"audit_failure",
+	"audit_pid",
+	"audit_rate_limit",
+	"audit_backlog_limit",
+	"audit_backlog_wait_time",
+	"audit_backlog_wait_time_actual",
+}
+
+// configChangeTunable adds the name of the changed kernel audit setting of a
+// CONFIG_CHANGE record as tunable and its new value as new, so that the
+// old/new pair can be read without knowing the name of the setting.
+func configChangeTunable(data map[string]Field) {
+	if _, found := data["old"]; !found {
+		return
+	}
+
+	for _, tunable := range auditTunables {
+		if field, found := data[tunable]; found {
+			data["tunable"] = newField(tunable)
+			data["new"] = newField(field.Value())
+			return
+		}
+	}
+}
+
+// errnoResultDetails groups errno names into the failure categories used by
+// result_detail.
+var errnoResultDetails = map[string]string{
+	"EACCES":       "denied",
+	"EPERM":        "denied",
+	"EROFS":        "denied",
+	"ENOENT":       "not-found",
+	"ENOTDIR":      "not-found",
+	"ENXIO":        "not-found",
+	"ENODEV":       "not-found",
+	"ESRCH":        "not-found",
+	"EEXIST":       "already-exists",
+	"ENOTEMPTY":    "already-exists",
+	"EINVAL":       "invalid",
+	"EBADF":        "invalid",
+	"EFAULT":       "invalid",
+	"ENAMETOOLONG": "invalid",
+	"ENOMEM":       "resource-exhausted",
+	"ENOSPC":       "resource-exhausted",
+	"EDQUOT":       "resource-exhausted",
+	"EMFILE":       "resource-exhausted",
+	"ENFILE":       "resource-exhausted",
+	"EAGAIN":       "interrupted",
+	"EINTR":        "interrupted",
+	"EINPROGRESS":  "in-progress",
+	"EALREADY":     "in-progress",
+	"EBUSY":        "busy",
+	"ETXTBSY":      "busy",
+	"ECONNREFUSED": "unreachable",
+	"EHOSTUNREACH": "unreachable",
+	"ENETUNREACH":  "unreachable",
+	"ETIMEDOUT":    "timeout",
+}
+
+// resultDetail adds a result_detail key that classifies why a failed result
+// failed based on the errno name in exit. It must run after result and exit.
+func resultDetail(data map[string]Field) {
+	result, found := data["result"]
+	if !found || result.Value() != "fail" {
+		return
+	}
+
+	exit, found := data["exit"]
+	if !found {
+		return
+	}
+
+	if detail, found := errnoResultDetails[exit.Value()]; found {
+		data["result_detail"] = newField(detail)
+	}
+}
+
 func auditRuleKey(msg *AuditMessage) {
 	field, found := msg.fields["key"]
 	if !found {
@@ -19,6 +19,7 @@ package auparse
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -27,9 +28,13 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime/pprof"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
+	"unicode"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -170,12 +175,1053 @@ func TestExtractKeyValuePairs(t *testing.T) {
 				"y": newField("z"),
 			},
 		},
+		{
+			`pid=1 msg='audit(1490137971.011:50406): op=login acct="root" res=success'`,
+			map[string]Field{
+				"pid":        newField("1"),
+				"msg_header": newField("1490137971.011:50406"),
+				"op":         newField("login"),
+				"acct":       {`"root"`, `root`},
+				"res":        newField("success"),
+			},
+		},
+		{
+			`opts=a=b,c=d key=op=PAM:setcred res=success`,
+			map[string]Field{
+				"opts": newField("a=b,c=d"),
+				"key":  newField("op=PAM:setcred"),
+				"res":  newField("success"),
+			},
+		},
+		{
+			`msg=op==x y=`,
+			map[string]Field{
+				"op": newField("=x"),
+			},
+		},
+		{
+			// ENRICHED format uses uppercase keys after a 0x1D separator.
+			"uid=1000 auid=1000\x1dUID=\"vagrant\" AUID=\"vagrant\"",
+			map[string]Field{
+				"uid":  newField("1000"),
+				"auid": newField("1000"),
+				"UID":  {`"vagrant"`, `vagrant`},
+				"AUID": {`"vagrant"`, `vagrant`},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		out := map[string]Field{}
+		extractKeyValuePairs(tc.in, out)
+		assert.Equal(t, tc.out, out, "failed on: %v", tc.in)
+	}
+}
+
+func TestParseLogLineEnriched(t *testing.T) {
+	const line = `type=USER_LOGIN msg=audit(1610903553.686:584): pid=2240 uid=0 auid=1000 ses=3 ` +
+		`msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.2 terminal=ssh res=success'` +
+		"\x1dUID=\"root\" AUID=\"vagrant\" ID=\"vagrant\""
+
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "1000", data["auid"])
+	assert.Equal(t, "success", data["result"])
+	assert.Equal(t, "root", data["UID"])
+	assert.Equal(t, "vagrant", data["AUID"])
+	assert.Equal(t, "vagrant", data["ID"])
+	assert.Len(t, data, 13)
+}
+
+func TestParseLogLineEnrichedSyscall(t *testing.T) {
+	for _, header := range []string{
+		`arch=c000003e syscall=257`,
+		// Interpreted values must not be parsed as numbers.
+		`arch=x86_64 syscall=openat`,
+	} {
+		line := `type=SYSCALL msg=audit(1610903553.686:590): ` + header + ` success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=0 a3=0 ` +
+			`items=1 ppid=1 pid=2240 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=3 comm="cat" ` +
+			`exe="/usr/bin/cat" key=(null)` + "\x1dARCH=x86_64 SYSCALL=openat AUID=\"vagrant\" UID=\"root\""
+
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "x86_64", data["arch"], header)
+		assert.Equal(t, "openat", data["syscall"], header)
+		assert.Equal(t, "x86_64", data["ARCH"], header)
+		assert.Equal(t, "openat", data["SYSCALL"], header)
+		assert.Empty(t, msg.EnrichmentErrors(), header)
+	}
+}
+
+func TestParseLogLineCRLF(t *testing.T) {
+	for _, line := range []string{
+		`type=USER_START msg=audit(1490137971.011:50406): pid=1 uid=0 auid=1000 ses=1 msg='op=PAM:session_open acct="root" exe="/usr/bin/sudo" hostname=? addr=? terminal=/dev/pts/0 res=success'` + "\r\n",
+		// Carriage return inside the quoted msg value.
+		`type=USER_START msg=audit(1490137971.011:50406): pid=1 uid=0 auid=1000 ses=1 msg='op=PAM:session_open acct="root" exe="/usr/bin/sudo" hostname=? addr=? terminal=/dev/pts/0 res=success` + "\r'\r\n",
+	} {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "/dev/pts/0", data["terminal"])
+		assert.Equal(t, "success", data["result"])
+		assert.NotContains(t, msg.RawData, "\r\n")
+	}
+}
+
+func TestNormalizeUnsetID(t *testing.T) {
+	for _, v := range []string{"4294967295", "-1", "unset", "UNSET", "(unknown(4294967295))", "unknown(4294967295)"} {
+		data := map[string]Field{"AUID": newField(v)}
+		normalizeUnsetID("AUID", data)
+		field := data["AUID"]
+		assert.Equal(t, "unset", field.Value(), v)
+	}
+
+	data := map[string]Field{"AUID": newField("root")}
+	normalizeUnsetID("AUID", data)
+	field := data["AUID"]
+	assert.Equal(t, "root", field.Value())
+
+	msg, err := ParseLogLine(`type=USER_AUTH msg=audit(1610903553.686:585): pid=2240 uid=0 auid=4294967295 ses=4294967295 ` +
+		`msg='op=PAM:authentication acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=success'` +
+		"\x1dUID=\"root\" AUID=\"(unknown(4294967295))\"")
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgData, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "unset", msgData["auid"])
+	assert.Equal(t, "unset", msgData["ses"])
+	assert.Equal(t, "unset", msgData["AUID"])
+}
+
+func TestParseSELinuxContext(t *testing.T) {
+	tests := []struct {
+		in  string
+		out map[string]Field
+	}{
+		{
+			"system_u:object_r:etc_t:s0",
+			map[string]Field{
+				"obj_user":            newField("system_u"),
+				"obj_role":            newField("object_r"),
+				"obj_domain":          newField("etc_t"),
+				"obj_level":           newField("s0"),
+				"obj_sensitivity_low": newField("s0"),
+			},
+		},
+		// A single level with categories.
+		{
+			"system_u:object_r:etc_t:s0:c1,c3",
+			map[string]Field{
+				"obj_user":            newField("system_u"),
+				"obj_role":            newField("object_r"),
+				"obj_domain":          newField("etc_t"),
+				"obj_level":           newField("s0:c1,c3"),
+				"obj_sensitivity_low": newField("s0"),
+				"obj_categories_low":  newField("c1,c3"),
+				"obj_category":        newField("c1,c3"),
+			},
+		},
+		// A range with categories only on the high level.
+		{
+			"unconfined_u:unconfined_r:unconfined_t:s0-s0:c0.c1023",
+			map[string]Field{
+				"obj_user":             newField("unconfined_u"),
+				"obj_role":             newField("unconfined_r"),
+				"obj_domain":           newField("unconfined_t"),
+				"obj_level":            newField("s0-s0:c0.c1023"),
+				"obj_sensitivity_low":  newField("s0"),
+				"obj_sensitivity_high": newField("s0"),
+				"obj_categories_high":  newField("c0.c1023"),
+				"obj_category":         newField("c0.c1023"),
+			},
+		},
+		// A range with categories only on the low level.
+		{
+			"system_u:system_r:sshd_t:s0:c0.c1023-s0",
+			map[string]Field{
+				"obj_user":             newField("system_u"),
+				"obj_role":             newField("system_r"),
+				"obj_domain":           newField("sshd_t"),
+				"obj_level":            newField("s0:c0.c1023-s0"),
+				"obj_sensitivity_low":  newField("s0"),
+				"obj_sensitivity_high": newField("s0"),
+				"obj_categories_low":   newField("c0.c1023"),
+				"obj_category":         newField("c0.c1023-s0"),
+			},
+		},
+		// More than five colon separated components.
+		{
+			"staff_u:staff_r:staff_t:s0:c1,c3-s2:c1.c5",
+			map[string]Field{
+				"obj_user":             newField("staff_u"),
+				"obj_role":             newField("staff_r"),
+				"obj_domain":           newField("staff_t"),
+				"obj_level":            newField("s0:c1,c3-s2:c1.c5"),
+				"obj_sensitivity_low":  newField("s0"),
+				"obj_sensitivity_high": newField("s2"),
+				"obj_categories_low":   newField("c1,c3"),
+				"obj_categories_high":  newField("c1.c5"),
+				"obj_category":         newField("c1,c3-s2:c1.c5"),
+			},
+		},
+		{
+			"system_u:system_r:kernel_t:s0",
+			map[string]Field{
+				"obj_user":            newField("system_u"),
+				"obj_role":            newField("system_r"),
+				"obj_domain":          newField("kernel_t"),
+				"obj_level":           newField("s0"),
+				"obj_sensitivity_low": newField("s0"),
+			},
+		},
+		// Special labels are kept as is.
+		{"kernel", map[string]Field{"obj": newField("kernel")}},
+		{"unlabeled", map[string]Field{"obj": newField("unlabeled")}},
+		// Empty parts do not produce keys.
+		{
+			"system_u::kernel_t",
+			map[string]Field{
+				"obj_user":   newField("system_u"),
+				"obj_domain": newField("kernel_t"),
+			},
+		},
+		{"?", map[string]Field{}},
+		{"(null)", map[string]Field{}},
+	}
+
+	for _, tc := range tests {
+		data := map[string]Field{"obj": newField(tc.in)}
+		if err := parseSELinuxContext("obj", data); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.out, data, "failed on: %v", tc.in)
+	}
+
+	msg, err := ParseLogLine(`type=PATH msg=audit(1490137971.011:50406): item=0 name="/etc/passwd" inode=1234 dev=fd:00 mode=0100644 ouid=0 ogid=0 rdev=00:00 obj=? nametype=NORMAL`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, data, "obj")
+	assert.NotContains(t, data, "obj_user")
+}
+
+func TestNormalizeTTY(t *testing.T) {
+	tests := []struct {
+		in, out string
+	}{
+		{"pts2", "pts2"},
+		{"(none)", "(none)"},
+		{"34818", "pts2"},
+		{"35073", "pts257"},
+		{"1025", "tty1"},
+		{"1088", "1088"}, // ttyS0 is not mapped.
+	}
+
+	for _, tc := range tests {
+		data := map[string]Field{"tty": newField(tc.in)}
+		normalizeTTY(data)
+		field := data["tty"]
+		assert.Equal(t, tc.out, field.Value(), "failed on: %v", tc.in)
+		assert.Equal(t, tc.in, field.Orig(), "failed on: %v", tc.in)
+	}
+}
+
+func TestAVCContexts(t *testing.T) {
+	msg, err := ParseLogLine(`type=AVC msg=audit(1611352800.410:1801): avc:  denied  { read } for  pid=1802 comm="httpd" path="/srv/www/index.html" dev="dm-0" ino=131 subj=system_u:system_r:httpd_t:s0 obj=unconfined_u:object_r:user_home_t:s0 scontext=system_u:system_r:httpd_t:s0 tcontext=unconfined_u:object_r:user_home_t:s0:c0.c1023 tclass=file permissive=0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for prefix, expected := range map[string][]string{
+		"subj":     {"system_u", "system_r", "httpd_t", "s0"},
+		"obj":      {"unconfined_u", "object_r", "user_home_t", "s0"},
+		"scontext": {"system_u", "system_r", "httpd_t", "s0"},
+		"tcontext": {"unconfined_u", "object_r", "user_home_t", "s0:c0.c1023"},
+	} {
+		assert.Equal(t, expected[0], data[prefix+"_user"], prefix)
+		assert.Equal(t, expected[1], data[prefix+"_role"], prefix)
+		assert.Equal(t, expected[2], data[prefix+"_domain"], prefix)
+		assert.Equal(t, expected[3], data[prefix+"_level"], prefix)
+		assert.Equal(t, "s0", data[prefix+"_sensitivity_low"], prefix)
+	}
+	assert.Equal(t, "c0.c1023", data["tcontext_categories_low"])
+
+	// The full contexts are kept because they are the subject and object of
+	// the event.
+	assert.Equal(t, "system_u:system_r:httpd_t:s0", data["scontext"])
+	assert.Equal(t, "unconfined_u:object_r:user_home_t:s0:c0.c1023", data["tcontext"])
+}
+
+func TestSELinuxErr(t *testing.T) {
+	msg, err := ParseLogLine(`type=SELINUX_ERR msg=audit(1311948547.151:138): op=security_compute_av reason=bounds scontext=system_u:system_r:anon_webapp_t:s0-s0:c0,c100,c200 tcontext=system_u:object_r:security_t:s0 tclass=dir perms=ioctl,read,lock`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "security_compute_av", data["op"])
+	assert.Equal(t, "bounds", data["reason"])
+	assert.Equal(t, "anon_webapp_t", data["scontext_domain"])
+	assert.Equal(t, "c0,c100,c200", data["scontext_categories_high"])
+	assert.Equal(t, "security_t", data["tcontext_domain"])
+	assert.Equal(t, "system_u:object_r:security_t:s0", data["tcontext"])
+
+	// Older kernels log the op as free text.
+	msg, err = ParseLogLine(`type=SELINUX_ERR msg=audit(1311948547.151:139): security_compute_sid:  invalid context unconfined_u:unconfined_r:mozilla_plugin_t:s0-s0:c0.c1023 for scontext=unconfined_u:unconfined_r:unconfined_t:s0-s0:c0.c1023 tcontext=system_u:object_r:mozilla_plugin_exec_t:s0 tclass=process`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "security_compute_sid", data["op"])
+	assert.Equal(t, "invalid context", data["reason"])
+	assert.Equal(t, "unconfined_u:unconfined_r:mozilla_plugin_t:s0-s0:c0.c1023", data["invalid_context"])
+	assert.Equal(t, "unconfined_t", data["scontext_domain"])
+	assert.Equal(t, "mozilla_plugin_exec_t", data["tcontext_domain"])
+	assert.Equal(t, "process", data["tclass"])
+
+	// Bounded transitions log the old and new contexts.
+	msg, err = ParseLogLine(`type=SELINUX_ERR msg=audit(1311948547.151:140): op=security_bounded_transition seresult=denied oldcontext=system_u:system_r:httpd_t:s0 newcontext=system_u:system_r:httpd_child_t:s0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "httpd_t", data["oldcontext_domain"])
+	assert.Equal(t, "httpd_child_t", data["newcontext_domain"])
+}
+
+func TestParseSELinuxContextHex(t *testing.T) {
+	data := map[string]Field{
+		"subj": newField("73797374656D5F753A73797374656D5F723A737368645F743A73302D73303A63302E6331303233"),
+		"obj":  newField("756E636F6E66696E65645F753A6F626A6563745F723A757365725F686F6D655F743A7330"),
+	}
+	for _, key := range []string{"subj", "obj"} {
+		if err := parseSELinuxContext(key, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assert.Equal(t, map[string]string{
+		"subj_user":             "system_u",
+		"subj_role":             "system_r",
+		"subj_domain":           "sshd_t",
+		"subj_level":            "s0-s0:c0.c1023",
+		"subj_sensitivity_low":  "s0",
+		"subj_sensitivity_high": "s0",
+		"subj_categories_high":  "c0.c1023",
+		"subj_category":         "c0.c1023",
+		"obj_user":              "unconfined_u",
+		"obj_role":              "object_r",
+		"obj_domain":            "user_home_t",
+		"obj_level":             "s0",
+		"obj_sensitivity_low":   "s0",
+	}, fieldValues(data))
+
+	// A quoted value is never hex decoded.
+	data = map[string]Field{"obj": {orig: `"ABCD"`, value: "ABCD"}}
+	if err := parseSELinuxContext("obj", data); err != nil {
+		t.Fatal(err)
+	}
+	obj := data["obj"]
+	assert.Equal(t, "ABCD", obj.Value())
+}
+
+func fieldValues(data map[string]Field) map[string]string {
+	values := make(map[string]string, len(data))
+	for k, f := range data {
+		values[k] = f.Value()
+	}
+	return values
+}
+
+func TestParseSubjectContextLSM(t *testing.T) {
+	tests := []struct {
+		line string
+		data map[string]string
+	}{
+		{
+			`type=USER_AVC msg=audit(1610903553.686:590): pid=1 uid=0 auid=4294967295 ses=4294967295 lsm=selinux subj=system_u:system_r:init_t:s0 msg='avc:  received policyload notice (seqno=2)  exe="/usr/lib/systemd/systemd" sauid=0 hostname=? addr=? terminal=?'`,
+			map[string]string{"lsm": "selinux", "subj_user": "system_u", "subj_domain": "init_t", "subj_level": "s0"},
+		},
+		{
+			`type=USER_START msg=audit(1610903553.686:591): pid=2240 uid=0 auid=1000 ses=3 lsm=AppArmor subj="/usr/sbin/sshd (enforce)" msg='op=PAM:session_open acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=success'`,
+			map[string]string{"lsm": "apparmor", "subj_profile": "/usr/sbin/sshd", "subj_mode": "enforce"},
+		},
+		{
+			`type=USER_START msg=audit(1610903553.686:592): pid=2240 uid=0 auid=1000 ses=3 lsm=apparmor subj=unconfined msg='op=PAM:session_open acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=success'`,
+			map[string]string{"lsm": "apparmor", "subj_profile": "unconfined"},
+		},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(tc.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NotContains(t, data, "subj", tc.line)
+		for k, v := range tc.data {
+			assert.Equal(t, v, data[k], k)
+		}
+		if data["lsm"] == "apparmor" {
+			assert.NotContains(t, data, "subj_user")
+		}
+	}
+}
+
+func TestPromiscuousMode(t *testing.T) {
+	msg, err := ParseLogLine(`type=ANOM_PROMISCUOUS msg=audit(1492734741.348:750): dev=ens4 prom=256 old_prom=0 auid=1001 uid=0 gid=0 ses=1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "ens4", data["dev"])
+	assert.Equal(t, "on", data["prom"])
+	assert.Equal(t, "off", data["old_prom"])
+}
+
+func TestEnrichmentErrors(t *testing.T) {
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1490137971.011:50406): arch=zzzz syscall=59 success=yes exit=0 a0=1 a1=2 a2=3 a3=4 items=2 ppid=1 pid=2 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="ls" exe=2F62696E2F6C73 key=(null)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "/bin/ls", data["exe"])
+	assert.Equal(t, "zzzz", data["arch"])
+	assert.Equal(t, "unset", data["auid"])
+	assert.Equal(t, "success", data["result"])
+	if assert.Len(t, msg.EnrichmentErrors(), 1) {
+		assert.Contains(t, msg.EnrichmentErrors()[0].Error(), "arch")
+	}
+
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1490137971.011:50406): arch=c000003e syscall=59 success=yes exit=0 exe="/bin/ls"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, msg.EnrichmentErrors())
+}
+
+func TestNestedAuditHeader(t *testing.T) {
+	// The user space message was logged two seconds before the kernel record.
+	msg, err := ParseLogLine(`type=USER_AUTH msg=audit(1490137973.511:50407): pid=1 uid=0 auid=4294967295 ses=4294967295 msg='audit(1490137971.011:50406): op=login acct="root" exe="/usr/sbin/sshd" hostname=? addr=? terminal=ssh res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "1490137971.011:50406", data["msg_header"])
+	assert.Equal(t, "login", data["op"])
+	assert.EqualValues(t, 50407, msg.Sequence)
+
+	msg, err = ParseLogLine(`type=USER_AUTH msg=audit(1490137973.511:50407): pid=1 uid=0 msg='op=login acct="root" res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, data, "msg_header")
+}
+
+func TestConfigChangeTunable(t *testing.T) {
+	msg, err := ParseLogLine(`type=CONFIG_CHANGE msg=audit(1492753795.844:15406): audit_backlog_limit=64 old=8192 auid=4294967295 ses=4294967295 subj=system_u:system_r:unconfined_service_t:s0 res=1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "audit_backlog_limit", data["tunable"])
+	assert.Equal(t, "64", data["new"])
+	assert.Equal(t, "8192", data["old"])
+	assert.Equal(t, "64", data["audit_backlog_limit"])
+
+	msg, err = ParseLogLine(`type=CONFIG_CHANGE msg=audit(1492749467.018:1209): auid=4294967295 ses=4294967295 op="add_rule" key="pam" list=4 res=1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, data, "tunable")
+	assert.NotContains(t, data, "new")
+}
+
+func TestExit(t *testing.T) {
+	tests := []struct {
+		exit  string
+		code  int
+		errno string
+	}{
+		{"0", 0, ""},
+		{"4096", 4096, ""},
+		{"-13", -13, "EACCES"},
+		{"-9999", -9999, ""},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1449): arch=c000003e syscall=0 success=yes exit=` + tc.exit + ` a0=3 a1=7ffd a2=1000 a3=0 exe="/usr/bin/cat"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		code, errno, ok, err := msg.Exit()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, ok, tc.exit)
+		assert.Equal(t, tc.code, code, tc.exit)
+		assert.Equal(t, tc.errno, errno, tc.exit)
+	}
+
+	msg, err := ParseLogLine(`type=CWD msg=audit(1611352422.102:1445): cwd="/root"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, ok, err := msg.Exit()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1449): arch=c000003e syscall=0 success=yes exit=x a0=3 a1=7ffd a2=1000 a3=0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, err = msg.Exit()
+	assert.Error(t, err)
+}
+
+func TestAccountManagement(t *testing.T) {
+	// useradd -G wheel alice
+	msg, err := ParseLogLine(`type=USER_MGMT msg=audit(1611353000.100:2001): pid=2001 uid=0 auid=1000 ses=2 subj=unconfined_u:unconfined_r:unconfined_t:s0-s0:c0.c1023 msg='op=add-user-to-group grp="wheel" acct="alice" exe="/usr/sbin/useradd" hostname=? addr=? terminal=pts/0 res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "add-user-to-group", data["op"])
+	assert.Equal(t, "wheel", data["grp"])
+	assert.Equal(t, "alice", data["acct"])
+	assert.Equal(t, "1000", data["auid"])
+	assert.Equal(t, "2", data["ses"])
+	assert.Equal(t, "success", data["result"])
+
+	// Names with special characters are hex encoded and unknown ids are unset.
+	msg, err = ParseLogLine(`type=GRP_MGMT msg=audit(1611353000.200:2002): pid=2002 uid=0 auid=4294967295 ses=4294967295 msg='op=modify-group grp=6D7920677270 acct=616C6963652062 id=4294967295 exe="/usr/sbin/groupmod" hostname=? addr=? terminal=? res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "my grp", data["grp"])
+	assert.Equal(t, "alice b", data["acct"])
+	assert.Equal(t, "unset", data["id"])
+	assert.Equal(t, "unset", data["auid"])
+	assert.Equal(t, "unset", data["ses"])
+}
+
+func TestFanotify(t *testing.T) {
+	for resp, expected := range map[string]string{"1": "allow", "2": "deny", "18": "deny", "7": "7"} {
+		msg, err := ParseLogLine(`type=FANOTIFY msg=audit(1611352600.310:1601): resp=` + resp + ` fan_type=1 fan_info=3137 subj_trust=2 obj_trust=2`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, data["resp"], resp)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	parse := func(line string) AuditMessage {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msg
+	}
+
+	a := parse(`type=CWD msg=audit(1611352422.102:1445): cwd="/root"`)
+	b := parse(`type=CWD msg=audit(1611352422.102:1445):  cwd=2F726F6F74   `)
+	assert.True(t, a.Equal(b))
+	assert.NotEqual(t, a.RawData, b.RawData)
+
+	for _, line := range []string{
+		`type=CWD msg=audit(1611352422.102:1445): cwd="/tmp"`,
+		`type=CWD msg=audit(1611352422.102:1446): cwd="/root"`,
+		`type=CWD msg=audit(1611352422.103:1445): cwd="/root"`,
+		`type=CWD msg=audit(1611352422.102:1445): cwd="/root" extra=1`,
+		`type=PATH msg=audit(1611352422.102:1445): cwd="/root"`,
+	} {
+		assert.False(t, a.Equal(parse(line)), line)
+	}
+
+	a = parse(`type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=1 a1=2 a2=3 a3=4 exe="/usr/bin/tee" key="hosts"`)
+	b = parse(`type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=1 a1=2 a2=3 a3=4 exe="/usr/bin/tee" key="passwd"`)
+	assert.False(t, a.Equal(b))
+}
+
+func TestOrderedFields(t *testing.T) {
+	msg, err := ParseLogLine(`type=USER_LOGIN msg=audit(1610903553.686:584): pid=2240 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.2 terminal=ssh res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields, err := msg.OrderedFields()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	for _, kv := range fields {
+		keys = append(keys, kv.Key)
+	}
+	assert.Equal(t, []string{"pid", "uid", "auid", "ses", "op", "id", "exe", "addr", "terminal", "result"}, keys)
+
+	data, _ := msg.Data()
+	assert.Len(t, fields, len(data))
+	for _, kv := range fields {
+		assert.Equal(t, data[kv.Key], kv.Value, kv.Key)
+	}
+}
+
+func TestRawBytes(t *testing.T) {
+	msg, err := ParseLogLine(`type=TTY msg=audit(1491924063.550:1065566): tty pid=27930 uid=1000 auid=1000 ses=762 major=136 minor=0 comm="bash" data=6C73000D7FFF1B5B41`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, found := msg.RawBytes("data")
+	if assert.True(t, found) {
+		assert.Equal(t, []byte{'l', 's', 0x00, '\r', 0x7f, 0xff, 0x1b, '[', 'A'}, b)
+	}
+
+	// Fields that were not hex encoded have no raw bytes.
+	_, found = msg.RawBytes("comm")
+	assert.False(t, found)
+	_, found = msg.RawBytes("major")
+	assert.False(t, found)
+	_, found = msg.RawBytes("missing")
+	assert.False(t, found)
+
+	// The NULs of a proctitle are replaced with spaces by Data only.
+	msg, err = ParseLogLine(`type=PROCTITLE msg=audit(1490137971.011:50406): proctitle=6C73002D6C`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "ls -l", data["proctitle"])
+	b, found = msg.RawBytes("proctitle")
+	if assert.True(t, found) {
+		assert.Equal(t, []byte("ls\x00-l"), b)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	parse := func(line string) *AuditMessage {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &msg
+	}
+
+	const line = `type=USER_LOGIN msg=audit(1610903553.686:584): pid=2240 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.2 terminal=ssh res=success'`
+	a, b := parse(line), parse(line)
+	assert.Equal(t, a.Fingerprint(), b.Fingerprint())
+
+	// Retransmits with a different timestamp and sequence are duplicates.
+	c := parse(`type=USER_LOGIN msg=audit(1610903554.001:590): pid=2240 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.2 terminal=ssh res=success'`)
+	assert.Equal(t, a.Fingerprint(), c.Fingerprint())
+
+	d := parse(`type=USER_LOGIN msg=audit(1610903553.686:584): pid=2251 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.3 terminal=ssh res=success'`)
+	assert.NotEqual(t, a.Fingerprint(), d.Fingerprint())
+	assert.NotEqual(t, a.Fingerprint("pid"), d.Fingerprint("pid"))
+	assert.Equal(t, a.Fingerprint("pid", "addr"), d.Fingerprint("pid", "addr"))
+
+	e := parse(`type=USER_LOGOUT msg=audit(1610903553.686:584): pid=2240 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.2 terminal=ssh res=success'`)
+	assert.NotEqual(t, a.Fingerprint(), e.Fingerprint())
+}
+
+func TestSortKey(t *testing.T) {
+	lines := []string{
+		`type=SYSCALL msg=audit(1490137971.012:50410): arch=c000003e syscall=1 success=yes exit=1`,
+		`type=SYSCALL msg=audit(1490137971.011:50408): arch=c000003e syscall=1 success=yes exit=1`,
+		`type=SYSCALL msg=audit(1490137972.001:50400): arch=c000003e syscall=1 success=yes exit=1`,
+		`type=SYSCALL msg=audit(1490137971.011:50406): arch=c000003e syscall=1 success=yes exit=1`,
+		`type=SYSCALL msg=audit(1490137971.011:50407): arch=c000003e syscall=1 success=yes exit=1`,
+	}
+
+	var msgs []AuditMessage
+	for _, line := range lines {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	// Sort twice from different starting orders to check the result is stable.
+	for i := 0; i < 2; i++ {
+		sorted := append([]AuditMessage(nil), msgs...)
+		if i == 1 {
+			for l, r := 0, len(sorted)-1; l < r; l, r = l+1, r-1 {
+				sorted[l], sorted[r] = sorted[r], sorted[l]
+			}
+		}
+		sort.Slice(sorted, func(a, b int) bool { return sorted[a].SortKey() < sorted[b].SortKey() })
+
+		var seqs []uint32
+		for _, msg := range sorted {
+			seqs = append(seqs, msg.Sequence)
+		}
+		assert.Equal(t, []uint32{50406, 50407, 50408, 50410, 50400}, seqs)
+	}
+}
+
+func TestSessionKey(t *testing.T) {
+	lines := []string{
+		`type=LOGIN msg=audit(1611352420.090:1430): pid=1499 uid=0 subj=system_u:system_r:sshd_t:s0-s0:c0.c1023 old-auid=4294967295 auid=1000 tty=(none) old-ses=4294967295 ses=2 res=1`,
+		`type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=241 a3=1b6 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="tee" exe="/usr/bin/tee" key="hosts"`,
+	}
+
+	for _, line := range lines {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := msg.SessionKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "1000:2", key, line)
+	}
+
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1446): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=0 a3=0 items=1 ppid=1 pid=400 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="systemd" exe="/usr/lib/systemd/systemd" key=(null)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := msg.SessionKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "unset", key)
+}
+
+func TestExecve(t *testing.T) {
+	// a2 is hex encoded because it contains a space. a3 looks like hex but is
+	// quoted so it must not be decoded.
+	msg, err := ParseLogLine(`type=EXECVE msg=audit(1611352900.101:1801): argc=4 a0="grep" a1="-r" a2=68656C6C6F20776F726C64 a3="deadbeef"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	argv, err := msg.Execve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"grep", "-r", "hello world", "deadbeef"}, argv)
+
+	msg, err = ParseLogLine(`type=EXECVE msg=audit(1611352900.101:1802): argc=2 a0="ls"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = msg.Execve()
+	assert.Error(t, err)
+
+	msg, err = ParseLogLine(`type=CWD msg=audit(1611352900.101:1801): cwd="/root"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = msg.Execve()
+	assert.Error(t, err)
+}
+
+func TestIsDenied(t *testing.T) {
+	tests := []struct {
+		line   string
+		denied bool
+	}{
+		{`type=AVC msg=audit(1549553401.674:1131): avc:  denied  { net_admin } for  pid=5237 comm="ip" capability=12  scontext=system_u:system_r:dhcpc_t:s0 tcontext=system_u:system_r:dhcpc_t:s0 tclass=capability permissive=0`, true},
+		{`type=AVC msg=audit(1549553401.674:1132): avc:  granted  { setenforce } for  pid=5237 comm="setenforce" scontext=unconfined_u:unconfined_r:unconfined_t:s0 tcontext=system_u:object_r:security_t:s0 tclass=security`, false},
+		{`type=SYSCALL msg=audit(1490137971.011:50406): arch=c000003e syscall=2 success=no exit=-13 a0=7ffd5a1c a1=0 a2=1b6 a3=0 items=1 ppid=1 pid=2 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=1 comm="cat" exe="/usr/bin/cat" key=(null)`, true},
+		{`type=SYSCALL msg=audit(1490137971.011:50407): arch=c000003e syscall=2 success=yes exit=3 a0=7ffd5a1c a1=0 a2=1b6 a3=0 items=1 ppid=1 pid=2 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=1 comm="cat" exe="/usr/bin/cat" key=(null)`, false},
+		{`type=SECCOMP msg=audit(1433785727.186:10262): auid=20003 uid=22 gid=22 ses=21 pid=11217 comm="sshd" exe="/usr/sbin/sshd" sig=31 arch=40000003 syscall=132 compat=0 ip=0xb7670aac code=0x0`, true},
+		{`type=SECCOMP msg=audit(1433785727.186:10263): auid=20003 uid=22 gid=22 ses=21 pid=11217 comm="sshd" exe="/usr/sbin/sshd" sig=0 arch=c000003e syscall=39 compat=0 ip=0x7f0aac code=0x7ffc0000`, false},
 	}
 
 	for _, tc := range tests {
-		out := map[string]Field{}
-		extractKeyValuePairs(tc.in, out)
-		assert.Equal(t, tc.out, out, "failed on: %v", tc.in)
+		msg, err := ParseLogLine(tc.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		denied, err := msg.IsDenied()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.denied, denied, tc.line)
+	}
+}
+
+func TestDaemonRecords(t *testing.T) {
+	tests := []struct {
+		line string
+		data map[string]string
+	}{
+		{
+			`type=DAEMON_START msg=audit(1610903553.072:4477): op=start ver=3.0 format=enriched kernel=5.10.0-1-amd64 auid=4294967295 pid=1234 uid=0 ses=4294967295 subj=system_u:system_r:auditd_t:s0 res=success`,
+			map[string]string{"op": "start", "ver": "3.0", "auid": "unset", "ses": "unset", "pid": "1234", "result": "success"},
+		},
+		{
+			`type=DAEMON_START msg=audit(1610903553.072:4477): auditd start, ver=2.8.5 format=raw kernel=3.10.0-1160.el7.x86_64 auid=4294967295 pid=653 uid=0 ses=4294967295 subj=system_u:system_r:auditd_t:s0 res=success`,
+			map[string]string{"op": "start", "ver": "2.8.5", "auid": "unset", "ses": "unset", "pid": "653", "result": "success"},
+		},
+		{
+			`type=DAEMON_END msg=audit(1610903560.001:4480): auditd normal halt, sending auid=0 pid=1 subj=system_u:system_r:init_t:s0 res=success`,
+			map[string]string{"op": "normal halt", "auid": "0", "pid": "1", "result": "success"},
+		},
+		{
+			`type=DAEMON_ACCEPT msg=audit(1610903553.072:4478): addr=192.168.1.10 port=60 res=success`,
+			map[string]string{"addr": "192.168.1.10", "port": "60", "result": "success"},
+		},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(tc.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range tc.data {
+			assert.Equal(t, v, data[k], "%v in %v", k, tc.line)
+		}
+	}
+}
+
+func TestConfigChangeOp(t *testing.T) {
+	tests := []struct {
+		op, raw, want string
+	}{
+		{`op="add_rule"`, "add_rule", "add"},
+		{`op=add`, "add", "add"},
+		{`op="add rule"`, "add rule", "add"},
+		{`op="remove_rule"`, "remove_rule", "remove"},
+		{`op=del_rule`, "del_rule", "remove"},
+		{`op="updated_rules"`, "updated_rules", "update"},
+		{`op=set`, "set", ""},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(`type=CONFIG_CHANGE msg=audit(1481077231.371:478): auid=1000 ses=3 ` + tc.op + ` key=(null) list=4 res=1`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The op is kept as it was logged.
+		assert.Equal(t, tc.raw, data["op"], tc.op)
+		assert.Equal(t, tc.want, data["rule_op"], tc.op)
+	}
+}
+
+func TestObjPID(t *testing.T) {
+	msgs := map[string]string{}
+	for _, line := range []string{
+		`type=SYSCALL msg=audit(1611352800.412:1801): arch=c000003e syscall=62 success=yes exit=0 a0=6f2 a1=f a2=0 a3=7f0c1d2e3f40 items=0 ppid=1500 pid=1802 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=2 comm="kill" exe="/usr/bin/kill" key="signals"`,
+		`type=OBJ_PID msg=audit(1611352800.412:1801): opid=1778 oauid=4294967295 ouid=0 oses=4294967295 obj=system_u:system_r:httpd_t:s0 ocomm="httpd"`,
+	} {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range data {
+			msgs[msg.RecordType.String()+"."+k] = v
+		}
+	}
+
+	assert.Equal(t, "kill", msgs["SYSCALL.syscall"])
+	assert.Equal(t, "SIGTERM", msgs["SYSCALL.a1_signal"])
+	assert.Equal(t, "1778", msgs["OBJ_PID.opid"])
+	assert.Equal(t, "unset", msgs["OBJ_PID.oauid"])
+	assert.Equal(t, "unset", msgs["OBJ_PID.oses"])
+	assert.Equal(t, "httpd_t", msgs["OBJ_PID.obj_domain"])
+	assert.Equal(t, "httpd", msgs["OBJ_PID.ocomm"])
+}
+
+func TestIPCSetPerm(t *testing.T) {
+	msg, err := ParseLogLine(`type=IPC_SET_PERM msg=audit(1611352700.120:1701): qbytes=4000 ouid=1000 ogid=4294967295 mode=0640`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, map[string]string{
+		"qbytes": "16384",
+		"ouid":   "1000",
+		"ogid":   "unset",
+		"mode":   "0640",
+		"perm":   "rw-r-----",
+	}, data)
+}
+
+func TestXfrmDirection(t *testing.T) {
+	tests := []struct {
+		line string
+		key  string
+		dir  string
+	}{
+		{`type=MAC_IPSEC_EVENT msg=audit(1611352800.101:1701): op=SPD-add auid=0 ses=1 subj=system_u:system_r:ipsec_t:s0 res=1 src=10.0.0.1 src_prefixlen=32 dst=10.0.0.2 dst_prefixlen=32 dir=1`, "dir", "out"},
+		{`type=MAC_IPSEC_EVENT msg=audit(1611352800.101:1702): op=SPD-delete auid=0 ses=1 res=1 src=10.0.0.2 dst=10.0.0.1 dir=out`, "dir", "out"},
+		{`type=MAC_IPSEC_EVENT msg=audit(1611352800.101:1703): op=SPD-add auid=0 ses=1 res=1 src=10.0.0.2 dst=10.0.0.1 dir=2`, "dir", "fwd"},
+		{`type=CRYPTO_IPSEC_SA msg=audit(1611352800.101:1704): pid=850 uid=0 auid=4294967295 ses=4294967295 msg='op=start conn-name="tunnel" connstate=2 satype=ipsec-esp samode=tunnel cipher=AES direction=0 laddr=10.0.0.1 raddr=10.0.0.2 res=success'`, "direction", "in"},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(tc.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.dir, data[tc.key], tc.line)
+	}
+}
+
+func TestSetSyscallNameArches(t *testing.T) {
+	tests := []struct {
+		arch, syscall string
+		archName      string
+		syscallName   string
+	}{
+		{"c000003e", "2", "x86_64", "open"},
+		// ppc64le has no table of its own.
+		{"c0000015", "5", "ppc64le", "open"},
+		// Unknown arch values fall back to the ELF machine number.
+		{"4000003e", "2", "unknown[4000003e]", "open"},
+		{"80000016", "5", "s390x", "open"},
+		{"ffff", "2", "unknown[ffff]", "2"},
+	}
+
+	for _, tc := range tests {
+		data := map[string]Field{
+			"arch":    newField(tc.arch),
+			"syscall": newField(tc.syscall),
+		}
+		if err := arch(data); err != nil {
+			t.Fatal(err)
+		}
+		if err := setSyscallName(data, nil); err != nil {
+			t.Fatal(err)
+		}
+		archField, syscallField := data["arch"], data["syscall"]
+		assert.Equal(t, tc.archName, archField.Value(), "arch=%v", tc.arch)
+		assert.Equal(t, tc.syscallName, syscallField.Value(), "arch=%v", tc.arch)
+	}
+}
+
+func TestSetSyscallNameX32(t *testing.T) {
+	tests := []struct {
+		syscall     string
+		syscallName string
+	}{
+		// execve has its own x32 number.
+		{"1073742344", "execve"},
+		// openat shares its number with x86_64.
+		{"1073742081", "openat"},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(`type=SYSCALL msg=audit(1490137971.011:50406): arch=c000003e syscall=` + tc.syscall +
+			` success=yes exit=0 a0=1 a1=2 a2=3 a3=4 items=1 ppid=1 pid=2 auid=0 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=1 comm="x32" exe="/usr/bin/x32" key=(null)`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.syscallName, data["syscall"], tc.syscall)
+		assert.Equal(t, "x86_64", data["arch"])
+		assert.Equal(t, "x32", data["abi"])
 	}
 }
 
@@ -428,6 +1474,46 @@ func readGoldenFile(name string) ([]*StoredAuditMessage, error) {
 	return out, nil
 }
 
+func TestEncodeNDJSON(t *testing.T) {
+	lines := []string{
+		syscallLogLine,
+		`type=CWD msg=audit(1490137971.011:50406): cwd="/"`,
+		`type=PROCTITLE msg=audit(1490137971.011:50406): proctitle=2F7573722F6C6962657865632F706F737466697800`,
+	}
+
+	var msgs []AuditMessage
+	for _, line := range lines {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	var buf bytes.Buffer
+	if err := EncodeNDJSON(&buf, msgs); err != nil {
+		t.Fatal(err)
+	}
+
+	s := bufio.NewScanner(&buf)
+	var records []map[string]interface{}
+	for s.Scan() {
+		var record map[string]interface{}
+		if err := json.Unmarshal(s.Bytes(), &record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, "SYSCALL", records[0]["record_type"])
+		assert.Equal(t, "connect", records[0]["syscall"])
+		assert.Equal(t, "CWD", records[1]["record_type"])
+		assert.Equal(t, "/", records[1]["cwd"])
+		assert.Equal(t, "PROCTITLE", records[2]["record_type"])
+	}
+}
+
 func BenchmarkParseAuditHeader(b *testing.B) {
 	msg := syscallMsg
 	for i := 0; i < b.N; i++ {
@@ -497,6 +1583,7 @@ func ExampleParseLogLine() {
 	//   "subj_domain": "postfix_master_t",
 	//   "subj_level": "s0",
 	//   "subj_role": "system_r",
+	//   "subj_sensitivity_low": "s0",
 	//   "subj_user": "system_u",
 	//   "suid": "0",
 	//   "syscall": "connect",
@@ -517,6 +1604,60 @@ func BenchmarkAuditMessage_Data(b *testing.B) {
 	}
 }
 
+func TestAuditMessageReset(t *testing.T) {
+	var m AuditMessage
+	if err := ParseInto(&m, AUDIT_SYSCALL, syscallMsg); err != nil {
+		t.Fatal(err)
+	}
+	data, err := m.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "connect", data["syscall"])
+
+	const pathMsg = `audit(1490137971.011:50407): item=0 name="/etc/hosts" inode=1 nametype=NORMAL`
+	if err = ParseInto(&m, AUDIT_PATH, pathMsg); err != nil {
+		t.Fatal(err)
+	}
+	assert.EqualValues(t, 50407, m.Sequence)
+	data, err = m.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "/etc/hosts", data["name"])
+	assert.NotContains(t, data, "syscall")
+
+	assert.Error(t, ParseInto(&m, AUDIT_PATH, "garbage"))
+	assert.Equal(t, AuditMessage{data: map[string]string{}}, m)
+}
+
+func BenchmarkParseData(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := Parse(AUDIT_SYSCALL, syscallMsg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		m.Data()
+	}
+}
+
+func BenchmarkParseIntoData(b *testing.B) {
+	pool := sync.Pool{New: func() interface{} { return new(AuditMessage) }}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m := pool.Get().(*AuditMessage)
+		if err := ParseInto(m, AUDIT_SYSCALL, syscallMsg); err != nil {
+			b.Fatal(err)
+		}
+		m.Data()
+		pool.Put(m)
+	}
+}
+
 func Benchmark_arch(b *testing.B) {
 	d := map[string]Field{}
 
@@ -533,7 +1674,7 @@ func Benchmark_setSyscallName(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		setSyscallName(d)
+		setSyscallName(d, nil)
 	}
 }
 
@@ -545,7 +1686,7 @@ func Benchmark_setSyscallNameArchKey(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		setSyscallName(d)
+		setSyscallName(d, nil)
 	}
 }
 
@@ -565,7 +1706,7 @@ func Benchmark_saddr(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		saddr(d)
+		saddr(d, false)
 	}
 }
 
@@ -579,6 +1720,150 @@ func Benchmark_execveArgs(b *testing.B) {
 	}
 }
 
+func TestHexDecodeNULMode(t *testing.T) {
+	// "ls\x00-l\x00"
+	const proctitle = "6C73002D6C00"
+	for mode, expected := range map[NULMode]string{
+		NULSpace:  "ls -l ",
+		NULStrip:  "ls-l",
+		NULKeep:   "ls\x00-l\x00",
+		NULEscape: `ls\x00-l\x00`,
+	} {
+		data := map[string]Field{"proctitle": newField(proctitle)}
+		if err := hexDecode("proctitle", data, mode); err != nil {
+			t.Fatal(err)
+		}
+		field := data["proctitle"]
+		assert.Equal(t, expected, field.Value(), "mode %d", mode)
+	}
+}
+
+func TestQuotedProctitle(t *testing.T) {
+	for proctitle, expected := range map[string]string{
+		`"cafe"`:          "cafe",
+		`"(sshd)"`:        "(sshd)",
+		`'deadbeef'`:      "deadbeef",
+		`636166650061`:    "cafe a",
+		`"/usr/bin/ls 0"`: "/usr/bin/ls 0",
+	} {
+		msg, err := ParseLogLine(`type=PROCTITLE msg=audit(1490137971.011:50406): proctitle=` + proctitle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, data["proctitle"], proctitle)
+	}
+}
+
+// reparseLines cover enrichments that are not in the testdata logs.
+var reparseLines = []string{
+	`type=SECCOMP msg=audit(1611352900.100:1901): auid=1000 uid=1000 gid=1000 ses=2 pid=1901 comm="a.out" exe="/tmp/a.out" sig=31 arch=c000003e syscall=2 compat=0 ip=0x7f0a2b3c4d5e code=0x0`,
+	`type=SOCKADDR msg=audit(1611352900.100:1902): saddr=020001BB010203040000000000000000`,
+	`type=IPC_SET_PERM msg=audit(1611352900.100:1903): qbytes=4000 ouid=1000 ogid=1000 mode=0640`,
+	`type=SYSCALL msg=audit(1611352900.100:1904): arch=c000003e syscall=133 success=yes exit=0 a0=7ffd2c1e9f12 a1=2190 a2=401 a3=0 items=1 pid=1904 auid=1000 uid=0 comm="mknod" exe="/usr/bin/mknod" key=(null)`,
+	`type=SYSCALL msg=audit(1611352900.100:1905): arch=c000003e syscall=54 success=no exit=-13 a0=3 a1=1 a2=2 a3=7ffd2c1e9f1c items=0 pid=1905 auid=1000 uid=1000 comm="nc" exe="/usr/bin/nc" key=(null)`,
+	`type=PATH msg=audit(1611352900.100:1906): item=0 name="/usr/bin/ping" inode=1234 dev=08:01 mode=0100755 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL cap_fp=2000 cap_fi=0 cap_fe=1 cap_fver=2`,
+	`type=CONFIG_CHANGE msg=audit(1611352900.100:1907): op=set audit_backlog_limit=8192 old=64 auid=1000 ses=2 res=1`,
+	`type=FANOTIFY msg=audit(1611352900.100:1908): resp=2`,
+	`type=ANOM_PROMISCUOUS msg=audit(1611352900.100:1909): dev=ens4 prom=256 old_prom=0 auid=1001 uid=0 gid=0 ses=1`,
+	`type=USER_CMD msg=audit(1611352900.100:1910): pid=1910 uid=1000 auid=1000 ses=2 msg='cwd="/home/user" cmd=6C73202D6C terminal=pts/0 res=success'`,
+	`type=PROCTITLE msg=audit(1611352900.100:1911): proctitle=6C73002D6C`,
+}
+
+// formatData formats the data of a message as a log line after it went
+// through ToMapStr, as a pipeline that re-parses its output would.
+func formatData(t testing.TB, msg *AuditMessage) string {
+	m := msg.ToMapStr()
+	for _, k := range []string{"record_type", "@timestamp", "sequence", "raw_msg", "tags", "error"} {
+		delete(m, k)
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "audit(%d.%03d:%d):", msg.Timestamp.Unix(), msg.Timestamp.Nanosecond()/int(time.Millisecond), msg.Sequence)
+	for _, k := range keys {
+		v, ok := m[k].(string)
+		if !ok {
+			t.Fatalf("unexpected type %T for %v", m[k], k)
+		}
+		// Values that cannot be quoted are hex encoded like the kernel does.
+		if strings.IndexFunc(v, func(r rune) bool { return r == '"' || !unicode.IsPrint(r) }) >= 0 {
+			fmt.Fprintf(&buf, " %s=%X", k, v)
+		} else {
+			fmt.Fprintf(&buf, " %s=\"%s\"", k, v)
+		}
+	}
+	return buf.String()
+}
+
+func TestReparseIsIdempotent(t *testing.T) {
+	lines := append([]string(nil), reparseLines...)
+	files, err := filepath.Glob("testdata/*.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range files {
+		content, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, strings.Split(string(content), "\n")...)
+	}
+
+	for _, line := range lines {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			continue
+		}
+		data, err := msg.Data()
+		if err != nil {
+			continue
+		}
+
+		formatted := formatData(t, &msg)
+		reparsed, err := Parse(msg.RecordType, formatted)
+		if err != nil {
+			t.Fatal(err, formatted)
+		}
+		reparsedData, err := reparsed.Data()
+		if err != nil {
+			t.Fatal(err, formatted)
+		}
+
+		assert.Equal(t, data, reparsedData, line)
+		assert.Len(t, reparsed.EnrichmentErrors(), len(msg.EnrichmentErrors()), formatted)
+	}
+}
+
+func TestResult(t *testing.T) {
+	for v, expected := range map[string]string{
+		"yes":     "success",
+		"no":      "fail",
+		"1":       "success",
+		"0":       "fail",
+		"success": "success",
+		"failed":  "fail",
+		"true":    "success",
+		"TRUE":    "success",
+		"false":   "fail",
+	} {
+		data := map[string]Field{"res": newField(v)}
+		if err := result(data); err != nil {
+			t.Fatal(err)
+		}
+		res := data["result"]
+		assert.Equal(t, expected, res.Value(), v)
+	}
+}
+
 func Benchmark_result(b *testing.B) {
 	d := map[string]Field{}
 
@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"bytes"
+
+	"github.com/elastic/go-libaudit/v2/sys"
+	"github.com/pkg/errors"
+)
+
+const (
+	nlmsgHeaderLen = 16   // Size of struct nlmsghdr.
+	nlmsgMinType   = 0x10 // Types below this are netlink control messages.
+)
+
+// ParseBatch splits a buffer of concatenated netlink messages, as received
+// from the kernel's audit netlink socket, and parses each audit record in it.
+// The record type of each message is taken from its netlink header, which is
+// in host byte order, and each message starts at a 4 byte boundary.
+//
+// Both framings that occur in practice are accepted. Per netlink(7) the
+// length in the header includes the header itself, but some kernels send
+// audit records whose length counts only the payload. The buffer is first
+// split using the standard framing and only if the lengths do not add up
+// using the payload-only one. If neither applies the error of the standard
+// framing is returned. Netlink control messages (e.g. NLMSG_DONE) are skipped.
+func ParseBatch(buf []byte) ([]AuditMessage, error) {
+	frames, err := splitNetlinkMessages(buf, true)
+	if err != nil {
+		var payloadErr error
+		if frames, payloadErr = splitNetlinkMessages(buf, false); payloadErr != nil {
+			return nil, err
+		}
+	}
+
+	var msgs []AuditMessage
+	for _, f := range frames {
+		if f.typ < nlmsgMinType {
+			continue
+		}
+		msg, err := Parse(AuditMessageType(f.typ), string(f.payload))
+		if err != nil {
+			return msgs, errors.Wrapf(err, "failed to parse message %v", len(msgs))
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+type netlinkFrame struct {
+	typ     uint16
+	payload []byte
+}
+
+// splitNetlinkMessages splits buf into netlink messages. includesHeader
+// controls whether the length in each header counts the header itself.
+func splitNetlinkMessages(buf []byte, includesHeader bool) ([]netlinkFrame, error) {
+	byteOrder := sys.GetEndian()
+
+	var frames []netlinkFrame
+	for offset := 0; offset < len(buf); {
+		if len(buf)-offset < nlmsgHeaderLen {
+			return nil, errors.Errorf("truncated netlink header at offset %v", offset)
+		}
+		length := int(byteOrder.Uint32(buf[offset:]))
+		typ := byteOrder.Uint16(buf[offset+4:])
+
+		if includesHeader {
+			if length < nlmsgHeaderLen {
+				return nil, errors.Errorf("netlink message at offset %v has "+
+					"length %v that is shorter than its header", offset, length)
+			}
+			length -= nlmsgHeaderLen
+		}
+
+		start := offset + nlmsgHeaderLen
+		if length < 0 || length > len(buf)-start {
+			return nil, errors.Errorf("netlink message at offset %v has "+
+				"length %v that exceeds the buffer", offset, length)
+		}
+		frames = append(frames, netlinkFrame{
+			typ:     typ,
+			payload: bytes.TrimRight(buf[start:start+length], "\x00"),
+		})
+		offset = start + (length+3)&^3
+	}
+	return frames, nil
+}
@@ -0,0 +1,127 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/elastic/go-libaudit/v2/sys"
+	"github.com/stretchr/testify/assert"
+)
+
+// netlinkMessage frames payload like the kernel's audit subsystem does, with a
+// length that excludes the header.
+func netlinkMessage(typ AuditMessageType, payload string) []byte {
+	buf := make([]byte, nlmsgHeaderLen+(len(payload)+3)&^3)
+	sys.GetEndian().PutUint32(buf, uint32(len(payload)))
+	sys.GetEndian().PutUint16(buf[4:], uint16(typ))
+	copy(buf[nlmsgHeaderLen:], payload)
+	return buf
+}
+
+// standardNetlinkMessage frames payload as described in netlink(7), with a
+// length that includes the header.
+func standardNetlinkMessage(typ AuditMessageType, payload string) []byte {
+	buf := make([]byte, nlmsgHeaderLen+(len(payload)+3)&^3)
+	sys.GetEndian().PutUint32(buf, uint32(nlmsgHeaderLen+len(payload)))
+	sys.GetEndian().PutUint16(buf[4:], uint16(typ))
+	copy(buf[nlmsgHeaderLen:], payload)
+	return buf
+}
+
+func TestParseBatch(t *testing.T) {
+	var buf []byte
+	buf = append(buf, netlinkMessage(AUDIT_SYSCALL, `audit(1490137971.011:50406): arch=c000003e syscall=42 success=yes exit=0 a0=15 a1=7ffd83722200 a2=6e a3=ea60 items=1 ppid=1 pid=1229 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="master" exe="/usr/libexec/postfix/master" key=(null)`)...)
+	buf = append(buf, netlinkMessage(AUDIT_SOCKADDR, "audit(1490137971.011:50406): saddr=01002F6465762F6C6F6700\x00")...)
+	// NLMSG_DONE is skipped.
+	buf = append(buf, netlinkMessage(3, "")...)
+
+	msgs, err := ParseBatch(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, msgs, 2) {
+		assert.Equal(t, AUDIT_SYSCALL, msgs[0].RecordType)
+		assert.EqualValues(t, 50406, msgs[0].Sequence)
+		data, err := msgs[0].Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "connect", data["syscall"])
+
+		assert.Equal(t, AUDIT_SOCKADDR, msgs[1].RecordType)
+		data, err = msgs[1].Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "/dev/log", data["path"])
+	}
+
+	// A message that is longer than the buffer is an error.
+	_, err = ParseBatch(buf[:len(buf)-nlmsgHeaderLen-4])
+	assert.Error(t, err)
+}
+
+func TestParseBatchStandardFraming(t *testing.T) {
+	var buf []byte
+	buf = append(buf, standardNetlinkMessage(AUDIT_SYSCALL, `audit(1490137971.011:50406): arch=c000003e syscall=42 success=yes exit=0 a0=15 a1=7ffd83722200 a2=6e a3=ea60 items=1 ppid=1 pid=1229 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="master" exe="/usr/libexec/postfix/master" key=(null)`)...)
+	buf = append(buf, standardNetlinkMessage(AUDIT_SOCKADDR, "audit(1490137971.011:50406): saddr=01002F6465762F6C6F6700")...)
+	// NLMSG_DONE with nlmsg_len=16.
+	buf = append(buf, standardNetlinkMessage(3, "")...)
+
+	msgs, err := ParseBatch(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, msgs, 2) {
+		assert.Equal(t, AUDIT_SYSCALL, msgs[0].RecordType)
+		assert.Equal(t, AUDIT_SOCKADDR, msgs[1].RecordType)
+		data, err := msgs[1].Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "/dev/log", data["path"])
+	}
+
+	// The payload-only framing also adds up for a record followed by
+	// NLMSG_DONE, but it would append the NLMSG_DONE header to the record.
+	buf = standardNetlinkMessage(AUDIT_SOCKADDR, "audit(1490137971.011:50406): saddr=01002F6465762F6C6F6700")
+	buf = append(buf, standardNetlinkMessage(3, "")...)
+	if _, err = splitNetlinkMessages(buf, false); err != nil {
+		t.Fatal("expected the payload-only framing to add up", err)
+	}
+	msgs, err = ParseBatch(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, msgs, 1) {
+		data, err := msgs[0].Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "audit(1490137971.011:50406): saddr=01002F6465762F6C6F6700", msgs[0].RawData)
+		assert.Equal(t, "/dev/log", data["path"])
+	}
+
+	// A length shorter than the header is rejected by both framings.
+	buf = standardNetlinkMessage(3, "")
+	sys.GetEndian().PutUint32(buf, 8)
+	buf = append(buf, 0, 0, 0, 0)
+	_, err = ParseBatch(buf)
+	assert.Error(t, err)
+}
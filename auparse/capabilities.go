@@ -0,0 +1,166 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"strconv"
+	"strings"
+)
+
+// capabilityNames maps Linux capability numbers to their names as defined in
+// include/uapi/linux/capability.h.
+var capabilityNames = []string{
+	0:  "CAP_CHOWN",
+	1:  "CAP_DAC_OVERRIDE",
+	2:  "CAP_DAC_READ_SEARCH",
+	3:  "CAP_FOWNER",
+	4:  "CAP_FSETID",
+	5:  "CAP_KILL",
+	6:  "CAP_SETGID",
+	7:  "CAP_SETUID",
+	8:  "CAP_SETPCAP",
+	9:  "CAP_LINUX_IMMUTABLE",
+	10: "CAP_NET_BIND_SERVICE",
+	11: "CAP_NET_BROADCAST",
+	12: "CAP_NET_ADMIN",
+	13: "CAP_NET_RAW",
+	14: "CAP_IPC_LOCK",
+	15: "CAP_IPC_OWNER",
+	16: "CAP_SYS_MODULE",
+	17: "CAP_SYS_RAWIO",
+	18: "CAP_SYS_CHROOT",
+	19: "CAP_SYS_PTRACE",
+	20: "CAP_SYS_PACCT",
+	21: "CAP_SYS_ADMIN",
+	22: "CAP_SYS_BOOT",
+	23: "CAP_SYS_NICE",
+	24: "CAP_SYS_RESOURCE",
+	25: "CAP_SYS_TIME",
+	26: "CAP_SYS_TTY_CONFIG",
+	27: "CAP_MKNOD",
+	28: "CAP_LEASE",
+	29: "CAP_AUDIT_WRITE",
+	30: "CAP_AUDIT_CONTROL",
+	31: "CAP_SETFCAP",
+	32: "CAP_MAC_OVERRIDE",
+	33: "CAP_MAC_ADMIN",
+	34: "CAP_SYSLOG",
+	35: "CAP_WAKE_ALARM",
+	36: "CAP_BLOCK_SUSPEND",
+	37: "CAP_AUDIT_READ",
+	38: "CAP_PERFMON",
+	39: "CAP_BPF",
+	40: "CAP_CHECKPOINT_RESTORE",
+}
+
+// capabilityName returns the name of the given capability number. Unknown
+// numbers are returned as a decimal string.
+func capabilityName(num int) string {
+	if num >= 0 && num < len(capabilityNames) {
+		return capabilityNames[num]
+	}
+	return strconv.Itoa(num)
+}
+
+// setCapabilityName converts a numeric capability field (e.g. capability=12
+// in AVC records with tclass=capability) to its name.
+func setCapabilityName(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found {
+		return
+	}
+
+	num, err := strconv.Atoi(field.Value())
+	if err != nil {
+		return
+	}
+
+	field.Set(capabilityName(num))
+	data[key] = field
+}
+
+// setCapabilityVersion converts the VFS capability revision of a file (e.g.
+// cap_fver=2 in PATH records or fver=2 in BPRM_FCAPS records) to its name (v1,
+// v2, or v3). A value of 0 means that the file has no capabilities and is
+// left as is.
+func setCapabilityVersion(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found {
+		return
+	}
+
+	switch field.Value() {
+	case "1", "2", "3":
+		field.Set("v" + field.Value())
+		data[key] = field
+	}
+}
+
+// Capability set keys of the records that contain them. The values are hex
+// encoded bit masks.
+var (
+	pathCapabilityKeys      = []string{"cap_fp", "cap_fi"}
+	bprmFcapsCapabilityKeys = []string{"fp", "fi",
+		"old_pp", "old_pi", "old_pe", "old_pa",
+		"new_pp", "new_pi", "new_pe", "new_pa",
+		"pp", "pi", "pe", "pa"}
+	capsetCapabilityKeys = []string{"cap_pi", "cap_pp", "cap_pe", "cap_pa"}
+)
+
+// setCapabilityMasks converts the capability sets of keys with
+// setCapabilityMask. The hex encoded values are preserved under _raw keys if
+// the Parser has SetRawCapabilities enabled.
+func setCapabilityMasks(keys []string, data map[string]Field, p *Parser) {
+	for _, key := range keys {
+		if p.rawCapabilitiesEnabled() {
+			preserveRawValue(key, data)
+		}
+		setCapabilityMask(key, data)
+	}
+}
+
+// setCapabilityMask converts a hex encoded capability set (e.g.
+// cap_pa=0000000000003000) to a comma separated list of the names of the
+// capabilities in the set. An empty set becomes none like in the output of
+// ausearch -i. Values that are not hex are left as is.
+func setCapabilityMask(key string, data map[string]Field) {
+	field, found := data[key]
+	if !found {
+		return
+	}
+
+	mask, err := strconv.ParseUint(field.Value(), 16, 64)
+	if err != nil {
+		return
+	}
+	if mask == 0 {
+		field.Set("none")
+		data[key] = field
+		return
+	}
+
+	var names []string
+	for i := 0; mask != 0; i++ {
+		if mask&1 != 0 {
+			names = append(names, capabilityName(i))
+		}
+		mask >>= 1
+	}
+	field.Set(strings.Join(names, ","))
+	data[key] = field
+}
@@ -0,0 +1,113 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAVCCapability(t *testing.T) {
+	msg, err := ParseLogLine(`type=AVC msg=audit(1549553401.674:1131): avc:  denied  { net_admin } for  pid=5237 comm="ip" capability=12  scontext=system_u:system_r:dhcpc_t:s0 tcontext=system_u:system_r:dhcpc_t:s0 tclass=capability permissive=0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "CAP_NET_ADMIN", data["capability"])
+	assert.Equal(t, "denied", data["seresult"])
+	assert.Equal(t, "capability", data["tclass"])
+}
+
+func TestCapabilityName(t *testing.T) {
+	assert.Equal(t, "CAP_CHOWN", capabilityName(0))
+	assert.Equal(t, "CAP_SYS_ADMIN", capabilityName(21))
+	assert.Equal(t, "99", capabilityName(99))
+}
+
+func TestCapabilityVersion(t *testing.T) {
+	tests := []struct {
+		key  string
+		line string
+	}{
+		{"cap_fver", `type=PATH msg=audit(1524849206.224:162938): item=0 name="/usr/bin/ping" inode=1050 dev=08:01 mode=0100755 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL cap_fp=0000000000003000 cap_fi=0000000000000000 cap_fe=1 cap_fver=2`},
+		{"fver", `type=BPRM_FCAPS msg=audit(1524849206.224:162938): fver=2 fp=0000000000003000 fi=0000000000000000 fe=1 old_pp=0000000000000000 old_pi=0000000000000000 old_pe=0000000000000000 old_pa=0000000000000000 pp=0000000000003000 pi=0000000000000000 pe=0000000000003000 pa=0000000000000000`},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(tc.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "v2", data[tc.key], tc.key)
+	}
+
+	data := map[string]Field{"cap_fver": newField("0")}
+	setCapabilityVersion("cap_fver", data)
+	field := data["cap_fver"]
+	assert.Equal(t, "0", field.Value())
+}
+
+func TestCapabilityMask(t *testing.T) {
+	msg, err := ParseLogLine(`type=CAPSET msg=audit(1611352900.510:1901): pid=1902 cap_pi=0000000000000000 cap_pp=0000000000003000 cap_pe=0000000000003000 cap_pa=0000000000002400`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "none", data["cap_pi"])
+	assert.Equal(t, "CAP_NET_ADMIN,CAP_NET_RAW", data["cap_pp"])
+	assert.Equal(t, "CAP_NET_ADMIN,CAP_NET_RAW", data["cap_pe"])
+	assert.Equal(t, "CAP_NET_BIND_SERVICE,CAP_NET_RAW", data["cap_pa"])
+
+	msg, err = ParseLogLine(`type=BPRM_FCAPS msg=audit(1524849206.224:162938): fver=2 fp=0000000000003000 fi=0000000000000000 fe=1 old_pp=0000000000000000 old_pi=0000000000000000 old_pe=0000000000000000 old_pa=0000000000000000 pp=0000000000003000 pi=0000000000000000 pe=0000000000003000 pa=0000000000000400`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "CAP_NET_ADMIN,CAP_NET_RAW", data["fp"])
+	assert.Equal(t, "none", data["fi"])
+	assert.Equal(t, "CAP_NET_BIND_SERVICE", data["pa"])
+	assert.Equal(t, "1", data["fe"])
+
+	fields := map[string]Field{"cap_pa": newField("10000000000")}
+	setCapabilityMask("cap_pa", fields)
+	field := fields["cap_pa"]
+	assert.Equal(t, "CAP_CHECKPOINT_RESTORE", field.Value())
+
+	fields = map[string]Field{"cap_pa": newField("(null)")}
+	setCapabilityMask("cap_pa", fields)
+	field = fields["cap_pa"]
+	assert.Equal(t, "(null)", field.Value())
+}
@@ -30,6 +30,18 @@ import (
 
 const nullTerminator = "\x00"
 
+// NULMode controls how NUL bytes are written when a hex encoded field is
+// decoded.
+type NULMode uint8
+
+// NUL handling modes.
+const (
+	NULSpace  NULMode = iota // Replace each NUL with a space.
+	NULStrip                 // Remove NULs.
+	NULKeep                  // Keep NULs as is.
+	NULEscape                // Replace each NUL with the escape sequence \x00.
+)
+
 func hexToString(h string) (string, error) {
 	output, err := decodeUppercaseHexString(h)
 	if err != nil {
@@ -44,6 +56,17 @@ func hexToString(h string) (string, error) {
 	return string(output), nil
 }
 
+// latin1ToUTF8 converts a Latin-1 (ISO-8859-1) string to UTF-8. Each byte is
+// the code point of the same value.
+func latin1ToUTF8(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s) * 2)
+	for i := 0; i < len(s); i++ {
+		sb.WriteRune(rune(s[i]))
+	}
+	return sb.String()
+}
+
 func hexToStrings(h string) ([]string, error) {
 	output, err := decodeUppercaseHexString(h)
 	if err != nil {
@@ -58,22 +81,32 @@ func hexToDec(h string) (int32, error) {
 	return int32(num), err
 }
 
-func hexToIP(h string) (string, error) {
-	if len(h) == 8 {
-		a1, _ := hexToDec(h[0:2])
-		a2, _ := hexToDec(h[2:4])
-		a3, _ := hexToDec(h[4:6])
-		a4, _ := hexToDec(h[6:8])
-		return fmt.Sprintf("%d.%d.%d.%d", a1, a2, a3, a4), nil
-	} else if len(h) == 32 {
-		b, err := hex.DecodeString(h)
-		if err != nil {
-			return "", err
-		}
-		return net.IP(b).String(), nil
+// hexToIP decodes a 4 or 16 byte hex encoded IP address. The address is
+// returned in its canonical lowercase form. If expanded is true then IPv6
+// addresses are returned in full form without zero compression (e.g.
+// 2001:0db8:0000:0000:0000:0000:0000:0001).
+func hexToIP(h string, expanded bool) (string, error) {
+	if len(h) != 8 && len(h) != 32 {
+		return "", errors.New("invalid size")
 	}
 
-	return "", errors.New("invalid size")
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return "", err
+	}
+	ip := net.IP(b)
+	if !expanded || len(b) != net.IPv6len || ip.To4() != nil {
+		return ip.String(), nil
+	}
+
+	var sb strings.Builder
+	for i := 0; i < net.IPv6len; i += 2 {
+		if i > 0 {
+			sb.WriteByte(':')
+		}
+		fmt.Fprintf(&sb, "%02x%02x", b[i], b[i+1])
+	}
+	return sb.String(), nil
 }
 
 // decodeUppercaseHex decodes src into hex.DecodedLen(len(src)) bytes,
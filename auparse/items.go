@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import "strings"
+
+// splitEmbeddedItems splits a SYSCALL message that has PATH items embedded in
+// it (e.g. "... items=2 item=0 name=... item=1 name=...") into the fields of
+// the SYSCALL record and the fields of each item. ENRICHED values are kept
+// with the SYSCALL fields. Quoted values are never split.
+func splitEmbeddedItems(msg string) (head string, items []string) {
+	var enriched string
+	if idx := strings.IndexByte(msg, enrichedSeparator); idx != -1 {
+		msg, enriched = msg[:idx], msg[idx:]
+	}
+
+	var starts []int
+	var quote byte
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case (i == 0 || msg[i-1] == ' ') && strings.HasPrefix(msg[i:], "item="):
+			starts = append(starts, i)
+		}
+	}
+	if len(starts) == 0 {
+		return msg + enriched, nil
+	}
+
+	for i, start := range starts {
+		end := len(msg)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		items = append(items, strings.TrimSpace(msg[start:end]))
+	}
+	return strings.TrimSpace(msg[:starts[0]]) + enriched, items
+}
+
+// EmbeddedItems returns the PATH items that are embedded in a SYSCALL
+// message, in the order in which they appear. The fields of the items are
+// not part of Data. Each item is decoded like a PATH record using the options
+// of the Parser that parsed the message, if any. nil is returned if the
+// message has no embedded items.
+func (m *AuditMessage) EmbeddedItems() ([]map[string]string, error) {
+	if _, err := m.Data(); err != nil {
+		return nil, err
+	}
+	if m.RecordType != AUDIT_SYSCALL {
+		return nil, nil
+	}
+
+	_, rawItems := splitEmbeddedItems(m.RawData[m.offset:])
+	if len(rawItems) == 0 {
+		return nil, nil
+	}
+
+	items := make([]map[string]string, 0, len(rawItems))
+	for _, raw := range rawItems {
+		fields := map[string]Field{}
+		extractKeyValuePairs(raw, fields)
+		enrichPath(fields, m.parser)
+		if m.parser.latin1PathsEnabled() {
+			latin1Path("name", fields)
+		}
+
+		item := make(map[string]string, len(fields))
+		for k, f := range fields {
+			if m.parser != nil && !m.parser.keep(k) {
+				continue
+			}
+			item[k] = f.Value()
+		}
+		if redact(item) != nil {
+			m.itemsRedacted = true
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
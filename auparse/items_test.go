@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddedItems(t *testing.T) {
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=82 success=yes exit=0 a0=7ffc3a2e7711 a1=7ffc3a2e7720 a2=0 a3=0 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="mv" exe="/usr/bin/mv" key=(null) ` +
+		`item=0 name="/tmp/a item=1" inode=12 dev=08:01 mode=0100644 ouid=0 ogid=0 rdev=00:00 nametype=DELETE ` +
+		`item=1 name=2F746D702F62 inode=12 dev=08:01 mode=0100644 ouid=0 ogid=0 rdev=00:00 obj=unconfined_u:object_r:user_tmp_t:s0 nametype=CREATE`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "rename", data["syscall"])
+	assert.Equal(t, "/usr/bin/mv", data["exe"])
+	assert.NotContains(t, data, "item")
+	assert.NotContains(t, data, "nametype")
+
+	items, err := msg.EmbeddedItems()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, "0", items[0]["item"])
+		assert.Equal(t, "/tmp/a item=1", items[0]["name"])
+		assert.Equal(t, "DELETE", items[0]["nametype"])
+		assert.Equal(t, "1", items[1]["item"])
+		assert.Equal(t, "/tmp/b", items[1]["name"])
+		assert.Equal(t, "user_tmp_t", items[1]["obj_domain"])
+	}
+
+	// Items are enriched like PATH records with the options of the Parser.
+	p := NewParser()
+	p.SetNULMode(NULEscape, "name")
+	p.SetLatin1Paths(true)
+	p.DenyFields("inode")
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1447): arch=c000003e syscall=82 success=yes exit=0 a0=1 a1=2 a2=0 a3=0 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="mv" exe="/usr/bin/mv" key=(null) ` +
+		`item=0 name=2F746D70002F61 inode=12 dev=08:01 mode=0100755 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL cap_fp=2000 cap_fver=2 ` +
+		`item=1 name=2F746D702FE9 inode=13 dev=08:01 mode=0100644 ouid=0 ogid=0 rdev=00:00 nametype=CREATE`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = p.Data(&msg); err != nil {
+		t.Fatal(err)
+	}
+	items, err = msg.EmbeddedItems()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, `/tmp\x00/a`, items[0]["name"])
+		assert.Equal(t, "CAP_NET_RAW", items[0]["cap_fp"])
+		assert.NotContains(t, items[0], "inode")
+		assert.Equal(t, "/tmp/é", items[1]["name"])
+	}
+
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1446): arch=c000003e syscall=82 success=yes exit=0 a0=1 a1=2 a2=0 a3=0 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="mv" exe="/usr/bin/mv" key=(null)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err = msg.EmbeddedItems()
+	assert.NoError(t, err)
+	assert.Nil(t, items)
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseJournalEntry reconstructs an AuditMessage from the fields of an audit
+// record that systemd-journald has stored (entries with _TRANSPORT=audit).
+// It uses _AUDIT_TYPE, _AUDIT_ID, _SOURCE_REALTIME_TIMESTAMP (or
+// __REALTIME_TIMESTAMP if absent) and MESSAGE. journald prefixes MESSAGE with
+// the name of the record type, which is removed.
+func ParseJournalEntry(fields map[string]string) (AuditMessage, error) {
+	typ, err := strconv.ParseUint(fields["_AUDIT_TYPE"], 10, 16)
+	if err != nil {
+		return AuditMessage{}, errors.Wrap(err, "invalid _AUDIT_TYPE")
+	}
+	seq, err := strconv.ParseUint(fields["_AUDIT_ID"], 10, 32)
+	if err != nil {
+		return AuditMessage{}, errors.Wrap(err, "invalid _AUDIT_ID")
+	}
+
+	ts, found := fields["_SOURCE_REALTIME_TIMESTAMP"]
+	if !found {
+		ts = fields["__REALTIME_TIMESTAMP"]
+	}
+	usec, err := strconv.ParseUint(ts, 10, 64)
+	if err != nil {
+		return AuditMessage{}, errors.Wrap(err, "invalid journal timestamp")
+	}
+
+	message, found := fields["MESSAGE"]
+	if !found {
+		return AuditMessage{}, errors.New("journal entry has no MESSAGE")
+	}
+	recordType := AuditMessageType(typ)
+	for _, prefix := range []string{fields["_AUDIT_TYPE_NAME"], recordType.CanonicalName(), "AUDIT" + fields["_AUDIT_TYPE"]} {
+		if prefix != "" && strings.HasPrefix(message, prefix+" ") {
+			message = message[len(prefix)+1:]
+			break
+		}
+	}
+
+	header := fmt.Sprintf("audit(%d.%03d:%d): ", usec/1e6, usec%1e6/1e3, seq)
+	return Parse(recordType, header+message)
+}
@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJournalEntry(t *testing.T) {
+	// Fields of `journalctl -o json _TRANSPORT=audit` for a SYSCALL record.
+	fields := map[string]string{
+		"_TRANSPORT":                 "audit",
+		"_AUDIT_TYPE":                "1300",
+		"_AUDIT_TYPE_NAME":           "SYSCALL",
+		"_AUDIT_ID":                  "1445",
+		"_SOURCE_REALTIME_TIMESTAMP": "1611352422102000",
+		"__REALTIME_TIMESTAMP":       "1611352422104311",
+		"_BOOT_ID":                   "2f6b4ad2c87e4a5cb1c3fbd8c7b1a6d4",
+		"SYSLOG_FACILITY":            "4",
+		"SYSLOG_IDENTIFIER":          "audit",
+		"MESSAGE":                    `SYSCALL arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffd3c5a2e5f a2=0 a3=0 items=1 ppid=1500 pid=1545 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="cat" exe="/usr/bin/cat" key="hosts"`,
+		"_AUDIT_FIELD_SYSCALL":       "257",
+	}
+
+	msg, err := ParseJournalEntry(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, AUDIT_SYSCALL, msg.RecordType)
+	assert.EqualValues(t, 1445, msg.Sequence)
+	assert.Equal(t, time.Unix(1611352422, 102*int64(time.Millisecond)).UTC(), msg.Timestamp)
+
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "openat", data["syscall"])
+	assert.Equal(t, "x86_64", data["arch"])
+	tags, _ := msg.Tags()
+	assert.Equal(t, []string{"hosts"}, tags)
+
+	// Older versions of journald do not have _AUDIT_TYPE_NAME or
+	// _SOURCE_REALTIME_TIMESTAMP and use AUDITnnnn for unknown types.
+	msg, err = ParseJournalEntry(map[string]string{
+		"_AUDIT_TYPE":          "1999",
+		"_AUDIT_ID":            "7",
+		"__REALTIME_TIMESTAMP": "1611352422500000",
+		"MESSAGE":              "AUDIT1999 foo=bar",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{"foo": "bar"}, data)
+
+	// The prefix is the canonical name even if the type has an alias.
+	RegisterTypeAlias(AUDIT_USER_LOGIN, "Anmeldung")
+	defer RegisterTypeAlias(AUDIT_USER_LOGIN, "")
+	msg, err = ParseJournalEntry(map[string]string{
+		"_AUDIT_TYPE":          "1112",
+		"_AUDIT_ID":            "8",
+		"__REALTIME_TIMESTAMP": "1611352422500000",
+		"MESSAGE":              "USER_LOGIN pid=2240 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe=\"/usr/sbin/sshd\" hostname=? addr=10.0.2.2 terminal=ssh res=success'",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "audit(1611352422.500:8): pid=2240 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe=\"/usr/sbin/sshd\" hostname=? addr=10.0.2.2 terminal=ssh res=success'", msg.RawData)
+	assert.Equal(t, "login", data["op"])
+
+	_, err = ParseJournalEntry(map[string]string{"MESSAGE": "SYSCALL arch=c000003e"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import "sync"
+
+var keyDescriptions = struct {
+	sync.RWMutex
+	m map[string]string
+}{
+	m: map[string]string{},
+}
+
+// RegisterKeyDescriptions registers human readable descriptions of audit rule
+// keys (e.g. "T1078" -> "Valid Accounts"). They are returned by
+// TagDescriptions and do not change the tags of a message. A key with an empty
+// description is removed. It is safe for concurrent use.
+func RegisterKeyDescriptions(descriptions map[string]string) {
+	keyDescriptions.Lock()
+	defer keyDescriptions.Unlock()
+
+	for key, desc := range descriptions {
+		if desc == "" {
+			delete(keyDescriptions.m, key)
+			continue
+		}
+		keyDescriptions.m[key] = desc
+	}
+}
+
+// TagDescriptions returns the registered descriptions of the message's tags
+// keyed by tag. Tags without a description are omitted and nil is returned if
+// none of the tags have one. See RegisterKeyDescriptions.
+func (m *AuditMessage) TagDescriptions() (map[string]string, error) {
+	tags, err := m.Tags()
+	if err != nil {
+		return nil, err
+	}
+
+	keyDescriptions.RLock()
+	defer keyDescriptions.RUnlock()
+
+	var descriptions map[string]string
+	for _, tag := range tags {
+		if desc, found := keyDescriptions.m[tag]; found {
+			if descriptions == nil {
+				descriptions = map[string]string{}
+			}
+			descriptions[tag] = desc
+		}
+	}
+	return descriptions, nil
+}
@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagDescriptions(t *testing.T) {
+	RegisterKeyDescriptions(map[string]string{"hosts": "Changes to the hosts file"})
+	defer RegisterKeyDescriptions(map[string]string{"hosts": ""})
+
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=241 a3=1b6 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="tee" exe="/usr/bin/tee" key="hosts"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	descriptions, err := msg.TagDescriptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{"hosts": "Changes to the hosts file"}, descriptions)
+
+	tags, err := msg.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"hosts"}, tags)
+
+	RegisterKeyDescriptions(map[string]string{"hosts": ""})
+	descriptions, err = msg.TagDescriptions()
+	assert.NoError(t, err)
+	assert.Nil(t, descriptions)
+}
@@ -0,0 +1,159 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// maxLogLineSize is the longest log line accepted by LogReader.
+const maxLogLineSize = 1 << 20
+
+// LogReader reads audit messages from a log in the format written by auditd
+// (e.g. /var/log/audit/audit.log), one message per line. Only the header of
+// each message is parsed; call Data on the returned message to parse its
+// body. A LogReader is not safe for concurrent use.
+//
+// Rotation markers (DAEMON_ROTATE records) are returned like any other
+// message so that callers can tell where one log file ends.
+type LogReader struct {
+	scanner     *bufio.Scanner
+	line        int
+	types       map[AuditMessageType]struct{}
+	skipInvalid bool
+	skipped     int
+}
+
+// NewLogReader returns a new LogReader that reads from r.
+func NewLogReader(r io.Reader) *LogReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	s.Split(scanLines)
+	return &LogReader{scanner: s}
+}
+
+// SetTypeFilter restricts the messages returned by Next to the given record
+// types. The type is read from each line before anything else is parsed, so
+// lines of other types are skipped cheaply. Calling it with no types removes
+// the filter.
+func (r *LogReader) SetTypeFilter(types ...AuditMessageType) {
+	if len(types) == 0 {
+		r.types = nil
+		return
+	}
+	r.types = make(map[AuditMessageType]struct{}, len(types))
+	for _, typ := range types {
+		r.types[typ] = struct{}{}
+	}
+}
+
+// SetSkipInvalid controls whether lines that cannot be parsed are skipped
+// rather than returned as errors. This is useful at rotation boundaries where
+// a log may begin or end with a partial line. Skipped lines are counted by
+// Skipped.
+func (r *LogReader) SetSkipInvalid(skip bool) {
+	r.skipInvalid = skip
+}
+
+// Skipped returns the number of lines that were skipped because they could not
+// be parsed. It is only non-zero when SetSkipInvalid is enabled.
+func (r *LogReader) Skipped() int {
+	return r.skipped
+}
+
+// scanLines is a bufio.SplitFunc like bufio.ScanLines except that a lone '\r'
+// also ends a line. This handles logs written with CRLF or CR line endings.
+func scanLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		if data[i] == '\n' {
+			return i + 1, data[:i], nil
+		}
+		// Need one more byte to know whether this is a CRLF.
+		if i+1 < len(data) {
+			if data[i+1] == '\n' {
+				return i + 2, data[:i], nil
+			}
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return i + 1, data[:i], nil
+		}
+		return 0, nil, nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// Next returns the next message. Blank lines, including lines containing only
+// the NUL padding left by a truncated log, are skipped. io.EOF is returned
+// when there are no more messages. If a line cannot be parsed then an error
+// containing the line number is returned and reading can continue with the
+// next call.
+func (r *LogReader) Next() (*AuditMessage, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := strings.TrimFunc(r.scanner.Text(), isSpaceOrNUL)
+		if line == "" {
+			continue
+		}
+
+		typ, msg, err := parseLogLineType(line)
+		if err != nil {
+			if r.skipInvalid {
+				r.skipped++
+				continue
+			}
+			return nil, errors.Wrapf(err, "line %d", r.line)
+		}
+		if r.types != nil {
+			if _, found := r.types[typ]; !found {
+				continue
+			}
+		}
+
+		m, err := Parse(typ, msg)
+		if err != nil {
+			if r.skipInvalid {
+				r.skipped++
+				continue
+			}
+			return nil, errors.Wrapf(err, "line %d", r.line)
+		}
+		return &m, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func isSpaceOrNUL(r rune) bool {
+	return r == 0 || unicode.IsSpace(r)
+}
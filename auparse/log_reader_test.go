@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const logReaderInput = `
+type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=241 a3=1b6 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="tee" exe="/usr/bin/tee" key="hosts"
+type=CWD msg=audit(1611352422.102:1445): cwd="/root"
+type=PATH msg=audit(1611352422.102:1445): item=0 name="/etc/" inode=131073 dev=08:01 mode=040755 ouid=0 ogid=0 rdev=00:00 nametype=PARENT
+
+type=PATH msg=audit(1611352422.102:1445): item=1 name="/etc/hosts" inode=131090 dev=08:01 mode=0100644 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL
+type=PROCTITLE msg=audit(1611352422.102:1445): proctitle=746565002F6574632F686F737473
+type=EOE msg=audit(1611352422.102:1445):
+`
+
+func readAll(t *testing.T, r *LogReader) []*AuditMessage {
+	var msgs []*AuditMessage
+	for {
+		msg, err := r.Next()
+		if err == io.EOF {
+			return msgs
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, msg)
+	}
+}
+
+func TestLogReader(t *testing.T) {
+	msgs := readAll(t, NewLogReader(strings.NewReader(logReaderInput)))
+	if assert.Len(t, msgs, 6) {
+		assert.Equal(t, AUDIT_SYSCALL, msgs[0].RecordType)
+		assert.EqualValues(t, 1445, msgs[0].Sequence)
+		assert.Equal(t, AUDIT_EOE, msgs[5].RecordType)
+	}
+}
+
+func TestLogReaderSetTypeFilter(t *testing.T) {
+	r := NewLogReader(strings.NewReader(logReaderInput))
+	r.SetTypeFilter(AUDIT_PATH, AUDIT_CWD)
+
+	msgs := readAll(t, r)
+	var types []AuditMessageType
+	for _, msg := range msgs {
+		types = append(types, msg.RecordType)
+	}
+	assert.Equal(t, []AuditMessageType{AUDIT_CWD, AUDIT_PATH, AUDIT_PATH}, types)
+
+	data, err := msgs[2].Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "/etc/hosts", data["name"])
+}
+
+func TestLogReaderInvalidLine(t *testing.T) {
+	r := NewLogReader(strings.NewReader("garbage\n" + `type=CWD msg=audit(1611352422.102:1445): cwd="/root"`))
+
+	_, err := r.Next()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "line 1")
+	}
+
+	msg, err := r.Next()
+	if assert.NoError(t, err) {
+		assert.Equal(t, AUDIT_CWD, msg.RecordType)
+	}
+}
+
+func TestLogReaderLineEndings(t *testing.T) {
+	for name, eol := range map[string]string{"crlf": "\r\n", "cr": "\r"} {
+		t.Run(name, func(t *testing.T) {
+			input := strings.Replace(strings.TrimPrefix(logReaderInput, "\n"), "\n", eol, -1)
+			msgs := readAll(t, NewLogReader(strings.NewReader(input)))
+			if !assert.Len(t, msgs, 6) {
+				return
+			}
+
+			data, err := msgs[1].Data()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "/root", data["cwd"])
+
+			data, err = msgs[3].Data()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "NORMAL", data["nametype"])
+		})
+	}
+}
+
+func TestLogReaderRotation(t *testing.T) {
+	const input = `type=CWD msg=audit(1611352422.102:1445): cwd="/root"
+type=DAEMON_ROTATE msg=audit(1611352500.000:1446): op=rotate-logs auid=0 pid=1 subj=system_u:system_r:init_t:s0 res=success
+type=PATH msg=audit(1611352422.102:14
+` + "\x00\x00\x00\x00\n\n" + `type=CWD msg=audit(1611352501.000:1447): cwd="/tmp"
+`
+
+	r := NewLogReader(strings.NewReader(input))
+	r.SetSkipInvalid(true)
+
+	msgs := readAll(t, r)
+	var types []AuditMessageType
+	for _, msg := range msgs {
+		types = append(types, msg.RecordType)
+	}
+	assert.Equal(t, []AuditMessageType{AUDIT_CWD, AUDIT_DAEMON_ROTATE, AUDIT_CWD}, types)
+	assert.Equal(t, 1, r.Skipped())
+
+	// Without skipping the partial line is reported.
+	r = NewLogReader(strings.NewReader(input))
+	var err error
+	for err == nil {
+		_, err = r.Next()
+	}
+	assert.Contains(t, err.Error(), "line 3")
+}
@@ -96,6 +96,15 @@ var auditMessageTypeToName = map[AuditMessageType]string{
 }
 
 func (t AuditMessageType) String() string {
+	if name, found := typeAlias(t); found {
+		return name
+	}
+	return t.CanonicalName()
+}
+
+// CanonicalName returns the kernel's name for the record type, ignoring any
+// alias registered with RegisterTypeAlias.
+func (t AuditMessageType) CanonicalName() string {
 	name, found := auditMessageTypeToName[t]
 	if found {
 		return name
@@ -132,6 +141,9 @@ func GetAuditMessageType(name string) (AuditMessageType, error) {
 	if found {
 		return typ, nil
 	}
+	if typ, found := typeFromAlias(name); found {
+		return typ, nil
+	}
 
 	// Parse type from UNKNOWN[1329].
 	start := strings.IndexByte(name, '[')
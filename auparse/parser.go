@@ -0,0 +1,381 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Parser parses the data contained in audit messages. It reuses its internal
+// buffers across messages and can be configured to drop fields so that they
+// never enter the result. A Parser is not safe for concurrent use.
+type Parser struct {
+	stats ParserStats // Updated atomically. Kept first for 64-bit alignment.
+
+	fields   map[string]Field
+	allow    map[string]struct{}
+	deny     map[string]struct{}
+	resolver SyscallResolver
+
+	resultDetail     bool
+	invertedResult   map[AuditMessageType]struct{}
+	addressExpansion bool
+	nulModes         map[string]NULMode
+	rawIDs           bool
+	rawCapabilities  bool
+	latin1Paths      bool
+	arch             AuditArch
+
+	unknownKeysEnabled bool
+	unknownKeysMu      sync.Mutex
+	unknownKeys        map[string]uint64
+}
+
+// ParserStats are counters of the work done by a Parser.
+type ParserStats struct {
+	Records          uint64 // Number of messages parsed.
+	Bytes            uint64 // Number of bytes of raw message data parsed.
+	EnrichmentErrors uint64 // Number of fields that failed to be enriched.
+}
+
+// SyscallResolver resolves syscall numbers to names. It allows syscall names
+// to be resolved for kernels whose syscall numbers differ from the built-in
+// AuditSyscalls tables.
+type SyscallResolver interface {
+	// SyscallName returns the name of syscall number num for the given
+	// architecture name (e.g. "x86_64"). If found is false then the built-in
+	// tables are used.
+	SyscallName(arch string, num int) (name string, found bool)
+}
+
+// NewParser returns a new Parser.
+func NewParser() *Parser {
+	return &Parser{
+		fields: map[string]Field{},
+	}
+}
+
+// AllowFields restricts the data returned by the Parser to the given keys.
+// The keys are matched against the enriched key names (e.g. "result" rather
+// than "success"). Calling it again adds to the set of allowed keys.
+func (p *Parser) AllowFields(keys ...string) {
+	if p.allow == nil {
+		p.allow = make(map[string]struct{}, len(keys))
+	}
+	for _, k := range keys {
+		p.allow[k] = struct{}{}
+	}
+}
+
+// DenyFields drops the given keys from the data returned by the Parser. Deny
+// takes precedence over AllowFields. Calling it again adds to the set of
+// denied keys.
+func (p *Parser) DenyFields(keys ...string) {
+	if p.deny == nil {
+		p.deny = make(map[string]struct{}, len(keys))
+	}
+	for _, k := range keys {
+		p.deny[k] = struct{}{}
+	}
+}
+
+// SetSyscallResolver sets a SyscallResolver that is consulted before the
+// built-in syscall tables when translating syscall numbers to names.
+func (p *Parser) SetSyscallResolver(r SyscallResolver) {
+	p.resolver = r
+}
+
+// SetResultDetail controls whether a result_detail key is added to failed
+// results. It classifies the errno name from exit into a category such as
+// "denied" (EACCES, EPERM) or "not-found" (ENOENT). It is disabled by default.
+func (p *Parser) SetResultDetail(enabled bool) {
+	p.resultDetail = enabled
+}
+
+// SetInvertedResult declares record types whose numeric res values have
+// inverted semantics, i.e. res=0 means success and res=1 means failure. This
+// is needed for modules that log such records. Textual values like
+// res=success are not affected. Calling it again adds to the set of types.
+func (p *Parser) SetInvertedResult(types ...AuditMessageType) {
+	if p.invertedResult == nil {
+		p.invertedResult = make(map[AuditMessageType]struct{}, len(types))
+	}
+	for _, typ := range types {
+		p.invertedResult[typ] = struct{}{}
+	}
+}
+
+// SetExpandedAddresses controls whether IPv6 addresses decoded from saddr are
+// written in full form without zero compression (e.g.
+// 2001:0db8:0000:0000:0000:0000:0000:0001) for tools that expect a fixed
+// width representation. By default the canonical compressed form is used.
+func (p *Parser) SetExpandedAddresses(enabled bool) {
+	p.addressExpansion = enabled
+}
+
+// SetNULMode sets how NUL bytes are handled when the hex encoded values of the
+// given keys (e.g. proctitle, name, or data) are decoded. By default NULs are
+// replaced with spaces. NULKeep preserves the NULs of raw TTY data.
+func (p *Parser) SetNULMode(mode NULMode, keys ...string) {
+	if p.nulModes == nil {
+		p.nulModes = make(map[string]NULMode, len(keys))
+	}
+	for _, k := range keys {
+		p.nulModes[k] = mode
+	}
+}
+
+// SetRawIDs controls whether the value of each auid, old-auid, and ses field
+// is also returned exactly as it was logged under a key with a _raw suffix
+// (e.g. auid_raw=4294967295 alongside auid=unset). It is disabled by default.
+func (p *Parser) SetRawIDs(enabled bool) {
+	p.rawIDs = enabled
+}
+
+// SetRawCapabilities controls whether the hex encoded value of each capability
+// set (e.g. cap_fp or pa) is also returned under a key with a _raw suffix
+// (e.g. cap_fp_raw=0000000000003000 alongside
+// cap_fp=CAP_NET_ADMIN,CAP_NET_RAW). It is disabled by default.
+func (p *Parser) SetRawCapabilities(enabled bool) {
+	p.rawCapabilities = enabled
+}
+
+// SetLatin1Paths controls whether hex encoded paths (cwd, exe, and name) that
+// are not valid UTF-8 are assumed to be Latin-1 and converted to UTF-8. For
+// example the byte 0xE9 becomes é. It is disabled by default.
+func (p *Parser) SetLatin1Paths(enabled bool) {
+	p.latin1Paths = enabled
+}
+
+// SetDefaultArch sets the architecture that is assumed for SYSCALL and
+// SECCOMP records that have no arch field (e.g. AUDIT_ARCH_X86_64 for the
+// host), so that their syscall names can still be resolved. The arch field of
+// a record always takes precedence. It is unset by default.
+func (p *Parser) SetDefaultArch(arch AuditArch) {
+	p.arch = arch
+}
+
+// SetUnknownKeys controls whether the Parser counts the keys that it
+// encountered but does not know, i.e. keys that the enrichment code does not
+// look at and that are not standard audit fields. The counts are returned by
+// UnknownKeys and help to find fields that are missing enrichment. It is
+// disabled by default.
+func (p *Parser) SetUnknownKeys(enabled bool) {
+	p.unknownKeysEnabled = enabled
+}
+
+// UnknownKeys returns a copy of the number of times each unknown key was
+// encountered since SetUnknownKeys was enabled. Like Stats it may be
+// called concurrently with Data.
+func (p *Parser) UnknownKeys() map[string]uint64 {
+	p.unknownKeysMu.Lock()
+	defer p.unknownKeysMu.Unlock()
+
+	out := make(map[string]uint64, len(p.unknownKeys))
+	for k, n := range p.unknownKeys {
+		out[k] = n
+	}
+	return out
+}
+
+// Data returns the key-value pairs contained in msg after applying the
+// Parser's field filters. Filtering is applied after enrichment so fields
+// that are needed for enrichment (e.g. arch) may be dropped without affecting
+// other fields. Like AuditMessage.Data the result is stored in msg, so a
+// message that has already been parsed is returned unchanged.
+func (p *Parser) Data(msg *AuditMessage) (map[string]string, error) {
+	if msg.parsed {
+		return msg.data, msg.error
+	}
+
+	data, err := msg.parseData(p.fields, map[string]string{}, p)
+	atomic.AddUint64(&p.stats.Records, 1)
+	atomic.AddUint64(&p.stats.Bytes, uint64(len(msg.RawData)))
+	atomic.AddUint64(&p.stats.EnrichmentErrors, uint64(len(msg.enrichErrors)))
+	return data, err
+}
+
+// Stats returns the counters of the Parser. Unlike the other methods of a
+// Parser it may be called concurrently with Data, for example to export the
+// counters as metrics.
+func (p *Parser) Stats() ParserStats {
+	return ParserStats{
+		Records:          atomic.LoadUint64(&p.stats.Records),
+		Bytes:            atomic.LoadUint64(&p.stats.Bytes),
+		EnrichmentErrors: atomic.LoadUint64(&p.stats.EnrichmentErrors),
+	}
+}
+
+// keep reports whether key passes the Parser's field filters.
+func (p *Parser) keep(key string) bool {
+	if _, denied := p.deny[key]; denied {
+		return false
+	}
+	if p.allow != nil {
+		_, allowed := p.allow[key]
+		return allowed
+	}
+	return true
+}
+
+// syscalls returns the Parser's SyscallResolver. It is safe to call on a nil
+// Parser.
+func (p *Parser) syscalls() SyscallResolver {
+	if p == nil {
+		return nil
+	}
+	return p.resolver
+}
+
+func (p *Parser) resultDetailEnabled() bool {
+	return p != nil && p.resultDetail
+}
+
+func (p *Parser) resultInverted(typ AuditMessageType) bool {
+	if p == nil {
+		return false
+	}
+	_, found := p.invertedResult[typ]
+	return found
+}
+
+func (p *Parser) expandedAddresses() bool {
+	return p != nil && p.addressExpansion
+}
+
+func (p *Parser) nulMode(key string) NULMode {
+	if p != nil {
+		if mode, found := p.nulModes[key]; found {
+			return mode
+		}
+	}
+	return NULSpace
+}
+
+func (p *Parser) rawIDsEnabled() bool {
+	return p != nil && p.rawIDs
+}
+
+func (p *Parser) rawCapabilitiesEnabled() bool {
+	return p != nil && p.rawCapabilities
+}
+
+func (p *Parser) latin1PathsEnabled() bool {
+	return p != nil && p.latin1Paths
+}
+
+// rawValues returns a copy of the raw values of fields if unknown keys are
+// counted. Otherwise it returns nil. It is safe to call on a nil Parser.
+func (p *Parser) rawValues(fields map[string]Field) map[string]string {
+	if p == nil || !p.unknownKeysEnabled {
+		return nil
+	}
+	raw := make(map[string]string, len(fields))
+	for k, f := range fields {
+		raw[k] = f.Value()
+	}
+	return raw
+}
+
+// countUnknownKeys counts the keys of raw that are not known to the
+// enrichment code and whose values were not changed by enrichment.
+func (p *Parser) countUnknownKeys(raw map[string]string, fields map[string]Field) {
+	p.unknownKeysMu.Lock()
+	defer p.unknownKeysMu.Unlock()
+
+	if p.unknownKeys == nil {
+		p.unknownKeys = map[string]uint64{}
+	}
+	for k, v := range raw {
+		if isKnownKey(k) {
+			continue
+		}
+		if f, found := fields[k]; found && f.Value() == v {
+			p.unknownKeys[k]++
+		}
+	}
+}
+
+// knownKeys contains the keys that the enrichment code reads or that are
+// logged by the kernel and user space audit tools with a value that needs no
+// enrichment (e.g. pid). It is based on the field table of libauparse. Keys
+// that are only added by enrichment (e.g. a1_signal) are never counted so they
+// are not listed.
+var knownKeys = map[string]struct{}{}
+
+func init() {
+	for _, keys := range [][]string{
+		unsetIDKeys,
+		bprmFcapsCapabilityKeys,
+		capsetCapabilityKeys,
+		pathCapabilityKeys,
+		{
+			"a0", "a1", "a2", "a3", "abi", "acct", "addr", "arch", "argc",
+			"audit_backlog_limit", "audit_backlog_wait_time", "audit_enabled",
+			"audit_failure", "audit_pid", "auid", "cap_fe", "cap_fver",
+			"cap_frootid", "capability", "cgroup", "cmd", "code", "comm", "cwd",
+			"data", "dev", "dir", "direction", "egid", "euid", "exe", "exit",
+			"family", "flags", "fsgid", "fsuid", "fver", "gid", "grantors",
+			"grp", "hostname", "id", "igid", "inode", "item", "items", "iuid",
+			"key", "laddr", "list", "lport", "lsm", "mask", "mode", "name",
+			"nametype", "new", "new-ses", "new_gid", "new_ns", "newcontext",
+			"oauid", "obj", "obj_gid", "obj_uid", "ocomm", "ogid", "old",
+			"old-ses", "old_ns", "old_prom", "oldcontext", "op", "opid", "oses",
+			"ouid", "pid", "perm", "ppid", "prom", "proctitle", "proto",
+			"qbytes", "rdev", "res", "resp", "result", "saddr", "sauid",
+			"scontext", "seresult", "ses", "sgid", "sig", "subj", "success",
+			"suid", "syscall", "tcontext", "terminal", "tty", "tunable", "uid",
+			"unit", "ver",
+		},
+	} {
+		for _, k := range keys {
+			knownKeys[k] = struct{}{}
+		}
+	}
+}
+
+// isKnownKey returns true if key is in knownKeys or is an EXECVE argument
+// (e.g. a1, a1_len, or a1[0]).
+func isKnownKey(key string) bool {
+	if _, found := knownKeys[key]; found {
+		return true
+	}
+	if len(key) < 2 || key[0] != 'a' {
+		return false
+	}
+	i := 1
+	for i < len(key) && key[i] >= '0' && key[i] <= '9' {
+		i++
+	}
+	switch rest := key[i:]; {
+	case i == 1:
+		return false
+	case rest == "" || rest == "_len":
+		return true
+	default:
+		return len(rest) > 2 && rest[0] == '[' && rest[len(rest)-1] == ']'
+	}
+}
+
+func (p *Parser) defaultArch() AuditArch {
+	if p == nil {
+		return 0
+	}
+	return p.arch
+}
@@ -0,0 +1,517 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParserDenyFields(t *testing.T) {
+	msg, err := ParseLogLine(syscallLogLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewParser()
+	p.DenyFields("a0", "a1", "a2", "a3", "arch")
+	data, err := p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, k := range []string{"a0", "a1", "a2", "a3", "arch"} {
+		assert.NotContains(t, data, k)
+	}
+	// syscall is still resolved even though arch was dropped.
+	assert.Equal(t, "connect", data["syscall"])
+	assert.Equal(t, "success", data["result"])
+}
+
+func TestParserAllowFields(t *testing.T) {
+	msg, err := ParseLogLine(syscallLogLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewParser()
+	p.AllowFields("syscall", "exe", "auid")
+	p.DenyFields("auid")
+	data, err := p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, map[string]string{
+		"syscall": "connect",
+		"exe":     "/usr/libexec/postfix/master",
+	}, data)
+}
+
+type vendorSyscalls map[int]string
+
+func (v vendorSyscalls) SyscallName(arch string, num int) (string, bool) {
+	if arch != "x86_64" {
+		return "", false
+	}
+	name, found := v[num]
+	return name, found
+}
+
+func TestParserSyscallResolver(t *testing.T) {
+	p := NewParser()
+	p.SetSyscallResolver(vendorSyscalls{42: "vendor_connect"})
+
+	msg, err := ParseLogLine(syscallLogLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "vendor_connect", data["syscall"])
+
+	// Numbers not known to the resolver use the built-in table.
+	msg, err = ParseLogLine(strings.Replace(syscallLogLine, "syscall=42", "syscall=59", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "execve", data["syscall"])
+}
+
+func TestParserResultDetail(t *testing.T) {
+	const line = `type=SYSCALL msg=audit(1490137971.011:50406): arch=c000003e syscall=257 ` +
+		`success=no exit=-13 a0=ffffff9c a1=7ffe5f8a2f4d a2=0 a3=0 items=1 ppid=1 pid=1229 ` +
+		`auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 ` +
+		`fsgid=1000 tty=pts0 ses=1 comm="cat" exe="/usr/bin/cat" key=(null)`
+
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, data, "result_detail")
+
+	p := NewParser()
+	p.SetResultDetail(true)
+	msg, err = ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "fail", data["result"])
+	assert.Equal(t, "EACCES", data["exit"])
+	assert.Equal(t, "denied", data["result_detail"])
+
+	// Successful results are not classified.
+	msg, err = ParseLogLine(syscallLogLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, data, "result_detail")
+}
+
+func TestParserInvertedResult(t *testing.T) {
+	const line = `type=USER_AUTH msg=audit(1611352420.090:1431): pid=1499 uid=0 auid=4294967295 ses=4294967295 msg='op=PAM:authentication acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=0'`
+
+	p := NewParser()
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "fail", data["result"])
+
+	p.SetInvertedResult(AUDIT_USER_AUTH)
+	msg, err = ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "success", data["result"])
+
+	// Other record types and textual values keep their meaning.
+	for _, line := range []string{
+		`type=USER_ACCT msg=audit(1611352420.090:1432): pid=1499 uid=0 auid=4294967295 ses=4294967295 msg='op=PAM:accounting acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=0'`,
+		`type=USER_AUTH msg=audit(1611352420.090:1433): pid=1499 uid=0 auid=4294967295 ses=4294967295 msg='op=PAM:authentication acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=failed'`,
+	} {
+		msg, err = ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err = p.Data(&msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "fail", data["result"], line)
+	}
+}
+
+func TestParserExpandedAddresses(t *testing.T) {
+	const line = `type=SOCKADDR msg=audit(1611352422.102:1446): saddr=0A000050000000002607F8B0400C0C06000000000000006700000000`
+
+	p := NewParser()
+	p.SetExpandedAddresses(true)
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "2607:f8b0:400c:0c06:0000:0000:0000:0067", data["addr"])
+	assert.Equal(t, "80", data["port"])
+}
+
+func TestParserNULMode(t *testing.T) {
+	const line = `type=PROCTITLE msg=audit(1611352422.102:1445): proctitle=746565002F6574632F686F737473`
+
+	for mode, expected := range map[NULMode]string{
+		NULSpace:  "tee /etc/hosts",
+		NULEscape: `tee\x00/etc/hosts`,
+	} {
+		p := NewParser()
+		p.SetNULMode(mode, "proctitle")
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := p.Data(&msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, data["proctitle"])
+	}
+
+	// TTY data uses spaces by default like every other field. Its NULs are
+	// kept when requested.
+	const tty = `type=TTY msg=audit(1611352422.102:1446): tty pid=1500 uid=0 auid=1000 ses=2 major=136 minor=0 comm="bash" data=6C73006C`
+	for mode, expected := range map[NULMode]string{
+		NULSpace: "ls l",
+		NULKeep:  "ls\x00l",
+	} {
+		p := NewParser()
+		if mode != NULSpace {
+			p.SetNULMode(mode, "data")
+		}
+		msg, err := ParseLogLine(tty)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := p.Data(&msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, data["data"])
+	}
+}
+
+func TestParserRawIDs(t *testing.T) {
+	const line = `type=LOGIN msg=audit(1611352420.090:1433): pid=1499 uid=0 subj=system_u:system_r:sshd_t:s0-s0:c0.c1023 old-auid=4294967295 auid=01000 tty=(none) old-ses=4294967295 ses=4 res=1`
+
+	p := NewParser()
+	p.SetRawIDs(true)
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// IDs that are not unset are never reformatted.
+	assert.Equal(t, "01000", data["auid"])
+	assert.Equal(t, "01000", data["auid_raw"])
+	assert.Equal(t, "unset", data["old-auid"])
+	assert.Equal(t, "4294967295", data["old-auid_raw"])
+	assert.Equal(t, "4", data["ses_raw"])
+
+	// Disabled by default.
+	msg, err = ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "01000", data["auid"])
+	assert.NotContains(t, data, "auid_raw")
+}
+
+func TestParserStats(t *testing.T) {
+	p := NewParser()
+	assert.Equal(t, ParserStats{}, p.Stats())
+
+	lines := []string{
+		syscallLogLine,
+		// arch is not a valid hex value.
+		`type=SYSCALL msg=audit(1611352422.102:1445): arch=zz syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=241 a3=1b6 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="tee" exe="/usr/bin/tee" key="hosts"`,
+	}
+
+	var expected ParserStats
+	for _, line := range lines {
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = p.Data(&msg); err != nil {
+			t.Fatal(err)
+		}
+		expected.Records++
+		expected.Bytes += uint64(len(msg.RawData))
+
+		// Data returns the stored result for a message that was parsed.
+		if _, err = p.Data(&msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected.EnrichmentErrors = 1
+
+	assert.Equal(t, expected, p.Stats())
+}
+
+func BenchmarkParserData(b *testing.B) {
+	p := NewParser()
+	b.SetBytes(int64(len(syscallMsg)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := Parse(AUDIT_SYSCALL, syscallMsg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		p.Data(&m)
+	}
+}
+
+func TestParserUnknownKeys(t *testing.T) {
+	p := NewParser()
+	p.SetUnknownKeys(true)
+
+	for i := 0; i < 2; i++ {
+		msg, err := ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1446): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=241 a3=1b6 items=2 ppid=1500 pid=1532 auid=1000 uid=0 comm="tee" exe="/usr/bin/tee" key="hosts" custom_key=42`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = p.Data(&msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	unknown := p.UnknownKeys()
+	assert.Equal(t, map[string]uint64{"custom_key": 2}, unknown)
+	// Known keys are not counted, whether or not their values are enriched.
+	assert.NotContains(t, unknown, "pid")
+	assert.NotContains(t, unknown, "auid")
+	assert.NotContains(t, unknown, "arch")
+	assert.NotContains(t, unknown, "syscall")
+	assert.NotContains(t, unknown, "success")
+
+	// Nothing is counted unless it is enabled.
+	p = NewParser()
+	msg, err := ParseLogLine(syscallLogLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = p.Data(&msg); err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, p.UnknownKeys())
+}
+
+// TestKnownKeysCoverEnrichment fails when the enrichment of a record in
+// testdata changes or removes a key that is not in knownKeys, because such a
+// key would be silently excluded from the unknown key counts.
+func TestKnownKeysCoverEnrichment(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no files found")
+	}
+
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			msg, err := ParseLogLine(s.Text())
+			if err != nil {
+				continue
+			}
+			message, err := normalizeAuditMessage(msg.RecordType, msg.RawData[msg.offset:])
+			if err != nil {
+				continue
+			}
+			raw := map[string]Field{}
+			extractKeyValuePairs(message, raw)
+
+			data, err := msg.Data()
+			if err != nil {
+				continue
+			}
+			for k, f := range raw {
+				if v, found := data[k]; found && v == f.Value() {
+					continue
+				}
+				assert.True(t, isKnownKey(k), "%v key %v is enriched but not known (%v)", msg.RecordType, k, name)
+			}
+		}
+		if err := s.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestParserRawCapabilities(t *testing.T) {
+	const line = `type=PATH msg=audit(1611352422.102:1445): item=0 name="/usr/bin/ping" inode=131090 dev=08:01 mode=0100755 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL cap_fp=0000000000003000 cap_fi=0000000000000000 cap_fe=1 cap_fver=2`
+
+	p := NewParser()
+	p.SetRawCapabilities(true)
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "CAP_NET_ADMIN,CAP_NET_RAW", data["cap_fp"])
+	assert.Equal(t, "0000000000003000", data["cap_fp_raw"])
+	assert.Equal(t, "none", data["cap_fi"])
+	assert.Equal(t, "0000000000000000", data["cap_fi_raw"])
+	assert.NotContains(t, data, "cap_fe_raw")
+
+	// Disabled by default.
+	msg, err = ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, data, "cap_fp_raw")
+}
+
+func TestParserLatin1Paths(t *testing.T) {
+	// name is "/tmp/caf\xe9" and cwd is "/tmp/café" in UTF-8.
+	const line = `type=PATH msg=audit(1611352422.102:1445): item=0 name=2F746D702F636166E9 inode=131090 dev=08:01 mode=0100644 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL`
+	const cwd = `type=CWD msg=audit(1611352422.102:1445): cwd=2F746D702F636166C3A9`
+
+	for enabled, name := range map[bool]string{true: "/tmp/café", false: "/tmp/caf\xe9"} {
+		p := NewParser()
+		p.SetLatin1Paths(enabled)
+
+		msg, err := ParseLogLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := p.Data(&msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, name, data["name"])
+
+		// Valid UTF-8 is never converted.
+		msg, err = ParseLogLine(cwd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err = p.Data(&msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "/tmp/café", data["cwd"])
+	}
+}
+
+func TestParserDefaultArch(t *testing.T) {
+	const line = `type=SYSCALL msg=audit(1611352422.102:1446): syscall=59 success=yes exit=0 a0=55d1 a1=55d2 a2=55d3 a3=0 items=2 ppid=1500 pid=1546 auid=1000 uid=0 gid=0 exe="/usr/bin/id"`
+
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := NewParser().Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "59", data["syscall"])
+
+	p := NewParser()
+	p.SetDefaultArch(AUDIT_ARCH_X86_64)
+
+	msg, err = ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "x86_64", data["arch"])
+	assert.Equal(t, "execve", data["syscall"])
+
+	// The arch of the record takes precedence.
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1447): arch=40000003 syscall=11 success=yes exit=0 a0=1 a1=2 a2=3 a3=0 exe="/usr/bin/id"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = p.Data(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "i386", data["arch"])
+	assert.Equal(t, "execve", data["syscall"])
+}
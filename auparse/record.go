@@ -0,0 +1,71 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import "time"
+
+// Record is the parsed form of an AuditMessage without the raw message text.
+// It only contains exported fields of basic types so that it can be
+// serialized compactly (e.g. with encoding/gob) and later be turned back into
+// an AuditMessage with ToAuditMessage.
+type Record struct {
+	RecordType AuditMessageType
+	Timestamp  time.Time
+	Sequence   uint32
+	Tags       []string
+	Fields     map[string]string // The data returned by AuditMessage.Data.
+}
+
+// Record returns the parsed data of the message as a Record. An error is
+// returned if the message could not be parsed.
+func (m *AuditMessage) Record() (*Record, error) {
+	data, err := m.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(data))
+	for k, v := range data {
+		fields[k] = v
+	}
+	return &Record{
+		RecordType: m.RecordType,
+		Timestamp:  m.Timestamp,
+		Sequence:   m.Sequence,
+		Tags:       append([]string(nil), m.tags...),
+		Fields:     fields,
+	}, nil
+}
+
+// ToAuditMessage reconstructs the AuditMessage of the Record. The message is
+// already parsed so Data returns the fields of the Record. RawData is empty
+// and enrichment errors are not preserved.
+func (r *Record) ToAuditMessage() AuditMessage {
+	data := make(map[string]string, len(r.Fields))
+	for k, v := range r.Fields {
+		data[k] = v
+	}
+	return AuditMessage{
+		RecordType: r.RecordType,
+		Timestamp:  r.Timestamp,
+		Sequence:   r.Sequence,
+		data:       data,
+		tags:       append([]string(nil), r.Tags...),
+		parsed:     true,
+	}
+}
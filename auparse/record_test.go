@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordRoundTrip(t *testing.T) {
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffd3c5a2e5f a2=0 a3=0 items=1 ppid=1500 pid=1545 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="cat" exe="/usr/bin/cat" key="hosts"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, err := msg.Record()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err = gob.NewEncoder(&buf).Encode(record); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, buf.Len() < 2*len(msg.RawData))
+
+	var decoded Record
+	if err = gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, *record, decoded)
+
+	out := decoded.ToAuditMessage()
+	assert.True(t, msg.Equal(out))
+	assert.Empty(t, out.RawData)
+
+	data, err := out.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "openat", data["syscall"])
+	tags, err := out.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"hosts"}, tags)
+
+	// Messages that cannot be parsed have no Record.
+	msg = AuditMessage{RecordType: AUDIT_SYSCALL, RawData: "x", offset: -1}
+	_, err = msg.Record()
+	assert.Error(t, err)
+}
@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// Redactor returns the value that is returned for key in place of value.
+// If keep is false the key is dropped.
+type Redactor func(key, value string) (redacted string, keep bool)
+
+var redactors = struct {
+	sync.RWMutex
+	names []string // Sorted names of the redactors to apply them in order.
+	m     map[string]Redactor
+}{
+	m: map[string]Redactor{},
+}
+
+// RegisterRedactor registers a Redactor under the given name. Redactors are
+// applied in the order of their names to the data of every message as it is
+// parsed, so they affect Data, EmbeddedItems, and ToMapStr. The raw message
+// can contain the redacted values in hex encoded form, so ToMapStr omits
+// raw_msg when a Redactor changed or dropped a field. Otherwise it passes the
+// raw message to them under the raw_msg key, and RawBytes returns nothing for
+// keys that were redacted. A nil Redactor removes the one with the given name.
+// It is safe for concurrent use.
+func RegisterRedactor(name string, r Redactor) {
+	redactors.Lock()
+	defer redactors.Unlock()
+
+	if r == nil {
+		delete(redactors.m, name)
+	} else {
+		redactors.m[name] = r
+	}
+
+	redactors.names = redactors.names[:0]
+	for name := range redactors.m {
+		redactors.names = append(redactors.names, name)
+	}
+	sort.Strings(redactors.names)
+}
+
+// RedactPattern returns a Redactor that replaces the parts of the values of
+// keys that match pattern with mask. Values of all keys are redacted if no
+// keys are given.
+func RedactPattern(pattern *regexp.Regexp, mask string, keys ...string) Redactor {
+	return func(key, value string) (string, bool) {
+		if len(keys) == 0 || containsString(keys, key) {
+			value = pattern.ReplaceAllLiteralString(value, mask)
+		}
+		return value, true
+	}
+}
+
+// DropKeys returns a Redactor that drops the given keys.
+func DropKeys(keys ...string) Redactor {
+	return func(key, value string) (string, bool) {
+		return value, !containsString(keys, key)
+	}
+}
+
+// hasRedactors reports whether any Redactor is registered.
+func hasRedactors() bool {
+	redactors.RLock()
+	defer redactors.RUnlock()
+	return len(redactors.names) > 0
+}
+
+// redact applies the registered redactors to data. It returns the keys whose
+// values were changed or dropped, or nil if there are none.
+func redact(data map[string]string) map[string]struct{} {
+	redactors.RLock()
+	defer redactors.RUnlock()
+
+	var redacted map[string]struct{}
+	for _, name := range redactors.names {
+		r := redactors.m[name]
+		for k, v := range data {
+			rv, keep := r(k, v)
+			switch {
+			case !keep:
+				delete(data, k)
+			case rv != v:
+				data[k] = rv
+			default:
+				continue
+			}
+			if redacted == nil {
+				redacted = map[string]struct{}{}
+			}
+			redacted[k] = struct{}{}
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,112 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactor(t *testing.T) {
+	// proctitle is "mysql -u root --password=hunter2".
+	const line = `type=PROCTITLE msg=audit(1611352422.102:1448): proctitle=6D7973716C002D7500726F6F74002D2D70617373776F72643D68756E74657232`
+
+	RegisterRedactor("password", RedactPattern(regexp.MustCompile(`--password=\S+`), "--password=***", "proctitle"))
+	RegisterRedactor("raw", DropKeys("raw_msg"))
+	defer RegisterRedactor("password", nil)
+	defer RegisterRedactor("raw", nil)
+
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "mysql -u root --password=***", data["proctitle"])
+
+	m := msg.ToMapStr()
+	assert.Equal(t, "mysql -u root --password=***", m["proctitle"])
+	assert.NotContains(t, m, "raw_msg")
+	assert.Equal(t, "PROCTITLE", m["record_type"])
+	_, found := msg.RawBytes("proctitle")
+	assert.False(t, found)
+
+	RegisterRedactor("password", nil)
+	RegisterRedactor("raw", nil)
+
+	msg, err = ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m = msg.ToMapStr()
+	assert.Equal(t, "mysql -u root --password=hunter2", m["proctitle"])
+	assert.Contains(t, m, "raw_msg")
+}
+
+func TestRedactorRawMessage(t *testing.T) {
+	// The hex encoded proctitle contains --password=hunter2.
+	const line = `type=PROCTITLE msg=audit(1611352422.102:1448): proctitle=6D7973716C002D7500726F6F74002D2D70617373776F72643D68756E74657232`
+
+	RegisterRedactor("password", RedactPattern(regexp.MustCompile(`--password=\S+`), "--password=***", "proctitle"))
+	defer RegisterRedactor("password", nil)
+
+	msg, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := msg.ToMapStr()
+	assert.Equal(t, "mysql -u root --password=***", m["proctitle"])
+	assert.NotContains(t, m, "raw_msg")
+	_, found := msg.RawBytes("proctitle")
+	assert.False(t, found)
+
+	// raw_msg is kept when nothing was redacted.
+	msg, err = ParseLogLine(`type=PROCTITLE msg=audit(1611352422.102:1449): proctitle=6C73002D6C`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m = msg.ToMapStr()
+	assert.Equal(t, "ls -l", m["proctitle"])
+	assert.Contains(t, m, "raw_msg")
+
+	// Redactors apply to embedded items, which are part of the raw message.
+	RegisterRedactor("names", RedactPattern(regexp.MustCompile(`secret`), "***", "name"))
+	defer RegisterRedactor("names", nil)
+	const syscall = `type=SYSCALL msg=audit(1611352422.102:1450): arch=c000003e syscall=2 success=yes exit=3 a0=1 a1=0 a2=0 a3=0 items=1 ppid=1 pid=2 auid=1000 uid=0 comm="cat" exe="/usr/bin/cat" key=(null) item=0 name=2F746D702F736563726574 inode=12 dev=08:01 mode=0100644 nametype=NORMAL`
+	msg, err = ParseLogLine(syscall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, msg.ToMapStr(), "raw_msg")
+
+	msg, err = ParseLogLine(syscall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := msg.EmbeddedItems()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, "/tmp/***", items[0]["name"])
+	}
+}
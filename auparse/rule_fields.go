@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// RuleFieldOperators are the comparison operators of an auditctl -F field
+// expression. Two character operators are listed first so that they match
+// before their one character prefixes.
+var RuleFieldOperators = []string{"!=", "<=", ">=", "&=", "=", "<", ">", "&"}
+
+// RuleField is a field expression of an audit rule (e.g. -F auid>=1000).
+type RuleField struct {
+	Field string `json:"field" yaml:"field"`
+	Op    string `json:"op"    yaml:"op"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// ParseRuleFields returns the -F field expressions of an audit rule written
+// in the auditctl format (e.g. "-a always,exit -F arch=b64 -S openat -F
+// auid>=1000"). Values may be quoted like in a shell (e.g. -F path="/a b").
+// Other options of the rule are ignored.
+func ParseRuleFields(rule string) ([]RuleField, error) {
+	tokens, err := SplitRuleArgs(rule)
+	if err != nil {
+		return nil, err
+	}
+	return ruleFields(tokens)
+}
+
+func ruleFields(tokens []string) ([]RuleField, error) {
+	var fields []RuleField
+	for i := 0; i < len(tokens); i++ {
+		var expr string
+		switch {
+		case tokens[i] == "-F":
+			if i+1 >= len(tokens) {
+				return nil, errors.New("missing expression after -F")
+			}
+			i++
+			expr = tokens[i]
+		case strings.HasPrefix(tokens[i], "-F"):
+			expr = tokens[i][2:]
+		default:
+			continue
+		}
+
+		field, err := ParseRuleField(expr)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// SplitRuleArgs splits an audit rule into its arguments like a shell does.
+// Whitespace within single or double quotes does not split and the quotes
+// are removed.
+func SplitRuleArgs(rule string) ([]string, error) {
+	var (
+		tokens  []string
+		token   strings.Builder
+		inToken bool
+		quote   rune
+	)
+	for _, r := range rule {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				token.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inToken = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inToken {
+				tokens = append(tokens, token.String())
+				token.Reset()
+				inToken = false
+			}
+		default:
+			token.WriteRune(r)
+			inToken = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.Errorf("unterminated quote in rule '%v'", rule)
+	}
+	if inToken {
+		tokens = append(tokens, token.String())
+	}
+	return tokens, nil
+}
+
+// ParseRuleField parses a single field expression of the form
+// 'field op value' (e.g. auid>=1000) where op is one of RuleFieldOperators.
+// The field is a name made of letters, digits, and underscores.
+func ParseRuleField(expr string) (RuleField, error) {
+	idx := strings.IndexAny(expr, "!=<>&")
+	if idx <= 0 || !isRuleFieldName(expr[:idx]) {
+		return RuleField{}, errors.Errorf("invalid field expression '%v'", expr)
+	}
+	for _, op := range RuleFieldOperators {
+		if strings.HasPrefix(expr[idx:], op) {
+			if len(expr) == idx+len(op) {
+				return RuleField{}, errors.Errorf("missing value in field expression '%v'", expr)
+			}
+			return RuleField{
+				Field: expr[:idx],
+				Op:    op,
+				Value: expr[idx+len(op):],
+			}, nil
+		}
+	}
+	return RuleField{}, errors.Errorf("invalid operator in field expression '%v'", expr)
+}
+
+func isRuleFieldName(s string) bool {
+	for _, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// RuleFields returns the -F field expressions of the rule that a
+// CONFIG_CHANGE record describes (e.g. "op=add_rule -F arch=b64 -F
+// auid>=1000 list=4 res=1"). nil is returned for records that contain no
+// field expressions, like those that only log the key and list of the rule.
+func (m *AuditMessage) RuleFields() ([]RuleField, error) {
+	if m.RecordType != AUDIT_CONFIG_CHANGE {
+		return nil, errors.Errorf("message type is %v, not CONFIG_CHANGE", m.RecordType)
+	}
+	if m.offset < 0 {
+		return nil, errMessageWithoutData
+	}
+
+	tokens, err := SplitRuleArgs(m.RawData[m.offset:])
+	if err != nil {
+		return nil, err
+	}
+	return ruleFields(tokens)
+}
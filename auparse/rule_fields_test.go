@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRuleFields(t *testing.T) {
+	fields, err := ParseRuleFields(`-a always,exit -F arch=b64 -S openat -F dir=/etc -F perm=wa -F auid>=1000 -F auid!=-1 -Fkey=etc`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []RuleField{
+		{Field: "arch", Op: "=", Value: "b64"},
+		{Field: "dir", Op: "=", Value: "/etc"},
+		{Field: "perm", Op: "=", Value: "wa"},
+		{Field: "auid", Op: ">=", Value: "1000"},
+		{Field: "auid", Op: "!=", Value: "-1"},
+		{Field: "key", Op: "=", Value: "etc"},
+	}, fields)
+
+	// Quoted values may contain spaces.
+	fields, err = ParseRuleFields(`-w /a -F path="/a b" -F 'key=two words' -F exe=/usr/bin/x"y z"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []RuleField{
+		{Field: "path", Op: "=", Value: "/a b"},
+		{Field: "key", Op: "=", Value: "two words"},
+		{Field: "exe", Op: "=", Value: "/usr/bin/xy z"},
+	}, fields)
+}
+
+func TestRuleFields(t *testing.T) {
+	msg, err := Parse(AUDIT_CONFIG_CHANGE, `audit(1611352900.100:1901): auid=1000 ses=2 op=add_rule -a always,exit -F arch=b64 -S openat -F dir=/etc -F perm=wa -F auid>=1000 -F path="/etc/my hosts" -F key=etc list=4 res=1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields, err := msg.RuleFields()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []RuleField{
+		{Field: "arch", Op: "=", Value: "b64"},
+		{Field: "dir", Op: "=", Value: "/etc"},
+		{Field: "perm", Op: "=", Value: "wa"},
+		{Field: "auid", Op: ">=", Value: "1000"},
+		{Field: "path", Op: "=", Value: "/etc/my hosts"},
+		{Field: "key", Op: "=", Value: "etc"},
+	}, fields)
+
+	// Records that only log the key and list of the rule have no fields.
+	msg, err = Parse(AUDIT_CONFIG_CHANGE, `audit(1492749467.018:1209): auid=4294967295 ses=4294967295 subj=system_u:system_r:unconfined_service_t:s0 op="add_rule" key="pam" list=4 res=1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields, err = msg.RuleFields()
+	assert.NoError(t, err)
+	assert.Nil(t, fields)
+
+	msg, err = Parse(AUDIT_CWD, `audit(1611352422.102:1445): cwd="/root"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = msg.RuleFields()
+	assert.Error(t, err)
+}
+
+func TestParseRuleFieldsInvalid(t *testing.T) {
+	for _, rule := range []string{"-a always,exit -F", "-F =1", "-F arch", "-F arch=", "-F a-b=1", `-F path="/a b`} {
+		_, err := ParseRuleFields(rule)
+		assert.Error(t, err, rule)
+	}
+}
@@ -18,14 +18,29 @@
 package auparse
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"strconv"
+	"strings"
+
+	"github.com/elastic/go-libaudit/v2/sys"
+	"github.com/pkg/errors"
 )
 
-func parseSockaddr(s string) (map[string]string, error) {
-	addressFamily, err := hexToDec(s[2:4] + s[0:2]) // host-order
+// parseSockaddr decodes a hex encoded sockaddr. The kernel logs the sockaddr
+// as it was passed to the syscall so the family and the other fields that are
+// not in network byte order are in the byte order of the host. If expanded is
+// true then IPv6 addresses are returned without zero compression.
+func parseSockaddr(s string, expanded bool) (map[string]string, error) {
+	if len(s) < 4 {
+		return nil, errors.New("sockaddr is too short")
+	}
+
+	b, err := hex.DecodeString(s[0:4])
 	if err != nil {
 		return nil, err
 	}
+	addressFamily := sys.GetEndian().Uint16(b)
 
 	out := map[string]string{}
 	switch addressFamily {
@@ -38,44 +53,96 @@ func parseSockaddr(s string) (map[string]string, error) {
 		out["family"] = "unix"
 		out["path"] = socket
 	case 2: // AF_INET
+		// The kernel may copy fewer bytes than a full sockaddr_in so only
+		// the fields that are present are decoded.
+		out["family"] = "ipv4"
+		if len(s) < 8 {
+			break
+		}
+
 		port, err := hexToDec(s[4:8])
 		if err != nil {
 			return nil, err
 		}
+		out["port"] = strconv.Itoa(int(port))
+		if len(s) < 16 {
+			break
+		}
 
-		ip, err := hexToIP(s[8:16])
+		ip, err := hexToIP(s[8:16], expanded)
 		if err != nil {
 			return nil, err
 		}
-
-		out["family"] = "ipv4"
 		out["addr"] = ip
-		out["port"] = strconv.Itoa(int(port))
 	case 10: // AF_INET6
+		// Like AF_INET only the fields that are present are decoded.
+		out["family"] = "ipv6"
+		if len(s) < 8 {
+			break
+		}
+
 		port, err := hexToDec(s[4:8])
 		if err != nil {
 			return nil, err
 		}
+		out["port"] = strconv.Itoa(int(port))
+		if len(s) < 16 {
+			break
+		}
 
 		flow, err := hexToDec(s[8:16])
 		if err != nil {
 			return nil, err
 		}
+		if flow > 0 {
+			out["flow"] = strconv.Itoa(int(flow))
+		}
+		if len(s) < 48 {
+			break
+		}
 
-		ip, err := hexToIP(s[16:48])
+		ip, err := hexToIP(s[16:48], expanded)
 		if err != nil {
 			return nil, err
 		}
-
-		out["family"] = "ipv6"
 		out["addr"] = ip
-		out["port"] = strconv.Itoa(int(port))
-		if flow > 0 {
-			out["flow"] = strconv.Itoa(int(flow))
+		if len(s) < 56 {
+			break
+		}
+
+		scope, err := hexToHostUint32(s[48:56])
+		if err != nil {
+			return nil, err
+		}
+		if scope > 0 {
+			out["scope_id"] = strconv.FormatUint(uint64(scope), 10)
 		}
 	case 16: // AF_NETLINK
 		out["family"] = "netlink"
 		out["saddr"] = s
+	case 31: // AF_BLUETOOTH
+		out["family"] = "bluetooth"
+		if err := parseBluetoothSockaddr(s, out); err != nil {
+			return nil, err
+		}
+	case 40: // AF_VSOCK
+		if len(s) < 24 {
+			return nil, errors.New("vsock sockaddr is too short")
+		}
+
+		port, err := hexToHostUint32(s[8:16])
+		if err != nil {
+			return nil, err
+		}
+
+		cid, err := hexToHostUint32(s[16:24])
+		if err != nil {
+			return nil, err
+		}
+
+		out["family"] = "vsock"
+		out["cid"] = strconv.FormatUint(uint64(cid), 10)
+		out["port"] = strconv.FormatUint(uint64(port), 10)
 	default:
 		out["family"] = strconv.Itoa(int(addressFamily))
 		out["saddr"] = s
@@ -83,3 +150,61 @@ func parseSockaddr(s string) (map[string]string, error) {
 
 	return out, nil
 }
+
+// parseBluetoothSockaddr decodes the fields of an AF_BLUETOOTH sockaddr. The
+// record does not contain the socket protocol so it is inferred from the size
+// of the sockaddr. Sockaddrs of an unknown size are returned as saddr.
+func parseBluetoothSockaddr(s string, out map[string]string) error {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+
+	switch len(b) {
+	case 6: // sockaddr_hci
+		out["protocol"] = "hci"
+		out["dev"] = strconv.Itoa(int(sys.GetEndian().Uint16(b[2:4])))
+		out["channel"] = strconv.Itoa(int(sys.GetEndian().Uint16(b[4:6])))
+	case 8: // sockaddr_sco
+		out["protocol"] = "sco"
+		out["addr"] = bdaddr(b[2:8])
+	case 9, 10: // sockaddr_rc (10 bytes with padding)
+		out["protocol"] = "rfcomm"
+		out["addr"] = bdaddr(b[2:8])
+		out["channel"] = strconv.Itoa(int(b[8]))
+	case 14: // sockaddr_l2
+		// The PSM and CID are little-endian on all hosts.
+		out["protocol"] = "l2cap"
+		out["psm"] = strconv.Itoa(int(binary.LittleEndian.Uint16(b[2:4])))
+		out["addr"] = bdaddr(b[4:10])
+		out["cid"] = strconv.Itoa(int(binary.LittleEndian.Uint16(b[10:12])))
+	default:
+		out["saddr"] = s
+	}
+	return nil
+}
+
+// bdaddr formats a Bluetooth device address that is stored in reverse byte
+// order (e.g. 00:1A:7D:DA:71:13).
+func bdaddr(b []byte) string {
+	addr := make([]byte, 0, 3*len(b))
+	for i := len(b) - 1; i >= 0; i-- {
+		if len(addr) > 0 {
+			addr = append(addr, ':')
+		}
+		addr = append(addr, hex.EncodeToString(b[i:i+1])...)
+	}
+	return strings.ToUpper(string(addr))
+}
+
+// hexToHostUint32 decodes a 4 byte hex value that is in host byte order.
+func hexToHostUint32(h string) (uint32, error) {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) != 4 {
+		return 0, errors.New("invalid size")
+	}
+	return sys.GetEndian().Uint32(b), nil
+}
@@ -18,8 +18,10 @@
 package auparse
 
 import (
+	"encoding/hex"
 	"testing"
 
+	"github.com/elastic/go-libaudit/v2/sys"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,10 +47,39 @@ func TestParseSockaddr(t *testing.T) {
 			"0A00084300000000000000000000000000000000000000000000000000000000281E7423FD7F0000C05034088F7F000007000000000000001E2D440000000000000000000000000060D758078F7F00000300000000000000C00F020000000000000000000000000005202302000000000200000000000000FFFFFFFFFFFFFFFF",
 			map[string]string{"family": "ipv6", "addr": "::", "port": "2115"},
 		},
+		{
+			// AF_VSOCK connect to cid 3 port 1234
+			"28000000D20400000300000000000000",
+			map[string]string{"family": "vsock", "cid": "3", "port": "1234"},
+		},
+		{
+			// AF_VSOCK bind to VMADDR_CID_ANY
+			"280000000F270000FFFFFFFF00000000",
+			map[string]string{"family": "vsock", "cid": "4294967295", "port": "9999"},
+		},
+		{
+			// AF_BLUETOOTH RFCOMM connect to 00:1A:7D:DA:71:13 channel 1
+			"1F001371DA7D1A000100",
+			map[string]string{"family": "bluetooth", "protocol": "rfcomm", "addr": "00:1A:7D:DA:71:13", "channel": "1"},
+		},
+		{
+			// AF_BLUETOOTH L2CAP connect to 00:1A:7D:DA:71:13 PSM 1 (SDP)
+			"1F0001001371DA7D1A0000000000",
+			map[string]string{"family": "bluetooth", "protocol": "l2cap", "addr": "00:1A:7D:DA:71:13", "psm": "1", "cid": "0"},
+		},
+		{
+			// AF_BLUETOOTH HCI bind to HCI_DEV_NONE on HCI_CHANNEL_CONTROL
+			"1F00FFFF0300",
+			map[string]string{"family": "bluetooth", "protocol": "hci", "dev": "65535", "channel": "3"},
+		},
+		{
+			"1F001371DA",
+			map[string]string{"family": "bluetooth", "saddr": "1F001371DA"},
+		},
 	}
 
 	for _, tc := range tests {
-		data, err := parseSockaddr(tc.saddr)
+		data, err := parseSockaddr(tc.saddr, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -56,3 +87,85 @@ func TestParseSockaddr(t *testing.T) {
 		assert.Equal(t, tc.data, data)
 	}
 }
+
+func TestParseSockaddrHostByteOrder(t *testing.T) {
+	// struct sockaddr_vm as it would be passed to connect on this host.
+	b := make([]byte, 16)
+	sys.GetEndian().PutUint16(b, 40)
+	sys.GetEndian().PutUint32(b[4:], 1234)
+	sys.GetEndian().PutUint32(b[8:], 3)
+
+	data, err := parseSockaddr(hex.EncodeToString(b), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{"family": "vsock", "cid": "3", "port": "1234"}, data)
+}
+
+func TestParseSockaddrExpanded(t *testing.T) {
+	tests := []struct {
+		saddr    string
+		compact  string
+		expanded string
+	}{
+		{
+			"02000050080808080000000000000000",
+			"8.8.8.8",
+			"8.8.8.8",
+		},
+		{
+			"0A000050000000002607F8B0400C0C06000000000000006700000000",
+			"2607:f8b0:400c:c06::67",
+			"2607:f8b0:400c:0c06:0000:0000:0000:0067",
+		},
+		{
+			// IPv4-mapped IPv6 address.
+			"0A0000500000000000000000000000000000FFFFC0A8010100000000",
+			"192.168.1.1",
+			"192.168.1.1",
+		},
+	}
+
+	for _, tc := range tests {
+		data, err := parseSockaddr(tc.saddr, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.compact, data["addr"])
+
+		data, err = parseSockaddr(tc.saddr, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.expanded, data["addr"])
+	}
+}
+
+func TestParseSockaddrShort(t *testing.T) {
+	tests := []struct {
+		saddr string
+		data  map[string]string
+	}{
+		{"0200", map[string]string{"family": "ipv4"}},
+		{"02000050", map[string]string{"family": "ipv4", "port": "80"}},
+		{"0200005008080808", map[string]string{"family": "ipv4", "addr": "8.8.8.8", "port": "80"}},
+		{"0A00", map[string]string{"family": "ipv6"}},
+		{"0A001F90", map[string]string{"family": "ipv6", "port": "8080"}},
+		{"0A001F9000000001", map[string]string{"family": "ipv6", "port": "8080", "flow": "1"}},
+		// The address is only decoded when all of its bytes are present.
+		{"0A001F900000000020010DB8", map[string]string{"family": "ipv6", "port": "8080"}},
+		{"0A001F900000000020010DB8000000000000000000000001", map[string]string{"family": "ipv6", "addr": "2001:db8::1", "port": "8080"}},
+		{"0A001F9000000000FE80000000000000000000000000000102000000", map[string]string{"family": "ipv6", "addr": "fe80::1", "port": "8080", "scope_id": "2"}},
+	}
+
+	for _, tc := range tests {
+		data, err := parseSockaddr(tc.saddr, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.data, data, tc.saddr)
+	}
+
+	_, err := parseSockaddr("02", false)
+	assert.Error(t, err)
+}
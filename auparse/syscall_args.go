@@ -0,0 +1,838 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// flagNames maps the bits of a flags argument to their names. The names are
+// listed in the order in which they are output.
+type flagNames []struct {
+	bit  uint64
+	name string
+}
+
+// format returns the names of the bits set in v joined by "|". Any bits that
+// have no name are appended as a hex value.
+func (f flagNames) format(v uint64) string {
+	var names []string
+	for _, flag := range f {
+		if v&flag.bit != 0 {
+			names = append(names, flag.name)
+			v &^= flag.bit
+		}
+	}
+	if v != 0 || len(names) == 0 {
+		names = append(names, "0x"+strconv.FormatUint(v, 16))
+	}
+	return strings.Join(names, "|")
+}
+
+// ipcFlags are the flags accepted by msgget, semget, and shmget as defined in
+// include/uapi/linux/ipc.h. The low 9 bits are the permission mode.
+var ipcFlags = flagNames{
+	{01000, "IPC_CREAT"},
+	{02000, "IPC_EXCL"},
+}
+
+// protFlags are the memory protection flags accepted by mmap and mprotect as
+// defined in include/uapi/asm-generic/mman-common.h.
+var protFlags = flagNames{
+	{0x1, "PROT_READ"},
+	{0x2, "PROT_WRITE"},
+	{0x4, "PROT_EXEC"},
+	{0x8, "PROT_SEM"},
+	{0x01000000, "PROT_GROWSDOWN"},
+	{0x02000000, "PROT_GROWSUP"},
+}
+
+// mmapTypes are the mapping types contained in the low bits of the mmap
+// flags, as defined in include/uapi/linux/mman.h.
+var mmapTypes = []string{"", "MAP_SHARED", "MAP_PRIVATE", "MAP_SHARED_VALIDATE"}
+
+// mmapFlagNames are the flags accepted by mmap, other than the mapping type,
+// as defined in include/uapi/asm-generic/mman-common.h and
+// arch/x86/include/uapi/asm/mman.h.
+var mmapFlagNames = flagNames{
+	{0x10, "MAP_FIXED"},
+	{0x20, "MAP_ANONYMOUS"},
+	{0x40, "MAP_32BIT"},
+	{0x100, "MAP_GROWSDOWN"},
+	{0x800, "MAP_DENYWRITE"},
+	{0x1000, "MAP_EXECUTABLE"},
+	{0x2000, "MAP_LOCKED"},
+	{0x4000, "MAP_NORESERVE"},
+	{0x8000, "MAP_POPULATE"},
+	{0x10000, "MAP_NONBLOCK"},
+	{0x20000, "MAP_STACK"},
+	{0x40000, "MAP_HUGETLB"},
+	{0x80000, "MAP_SYNC"},
+	{0x100000, "MAP_FIXED_NOREPLACE"},
+	{0x4000000, "MAP_UNINITIALIZED"},
+}
+
+// mremapFlags are the flags accepted by mremap as defined in
+// include/uapi/linux/mman.h.
+var mremapFlags = flagNames{
+	{1, "MREMAP_MAYMOVE"},
+	{2, "MREMAP_FIXED"},
+	{4, "MREMAP_DONTUNMAP"},
+}
+
+// openAccessModes are the access modes contained in the low bits of open
+// flags, as defined in include/uapi/asm-generic/fcntl.h.
+var openAccessModes = []string{"O_RDONLY", "O_WRONLY", "O_RDWR"}
+
+// mqOpenFlags are the flags accepted by mq_open, other than the access mode.
+var mqOpenFlags = flagNames{
+	{00100, "O_CREAT"},
+	{00200, "O_EXCL"},
+	{04000, "O_NONBLOCK"},
+	{02000000, "O_CLOEXEC"},
+}
+
+// syscallArg returns the value of the hex encoded syscall argument (a0-a3).
+func syscallArg(data map[string]Field, key string) (uint64, bool) {
+	field, found := data[key]
+	if !found {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(field.Value(), 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// syscallArgs decodes selected arguments of well-known syscalls into
+// additional fields. Each field is prefixed with the key that it is decoded
+// from (e.g. a2_flags or exit_bytes) so that it does not collide with the
+// fields of other record types, such as the mode of PATH records. The raw
+// a0-a3 fields are left in place. It must be called after the syscall name
+// has been resolved.
+func syscallArgs(data map[string]Field) {
+	syscall, found := data["syscall"]
+	if !found {
+		return
+	}
+
+	switch syscall.Value() {
+	case "msgget":
+		ipcGetFlags("a1", data)
+	case "semget", "shmget":
+		ipcGetFlags("a2", data)
+	case "mq_open":
+		mqOpenArgs(data)
+	case "mmap", "mmap2":
+		protArg("a2", data)
+		mmapFlags("a3", data)
+	case "mprotect", "pkey_mprotect":
+		protArg("a2", data)
+	case "mremap":
+		flagsArg("a3", mremapFlags, data)
+	case "rt_sigaction", "sigaction":
+		signalArg("a0", data)
+	case "kill", "tkill":
+		pidArg("a0", data)
+		signalArg("a1", data)
+	case "tgkill":
+		pidArg("a1", data)
+		signalArg("a2", data)
+	case "ptrace":
+		pidArg("a1", data)
+	case "sched_setaffinity", "sched_getaffinity", "sched_setscheduler",
+		"sched_setparam", "sched_setattr", "prlimit64", "pidfd_open",
+		"process_vm_readv", "process_vm_writev":
+		pidArg("a0", data)
+	case "rt_sigprocmask", "sigprocmask":
+		sigprocmaskHow("a0", data)
+	case "prctl":
+		prctlOption("a0", data)
+	case "umask":
+		umaskArg("a0", data)
+	case "setsockopt", "getsockopt":
+		sockoptArgs(data)
+	case "mknod":
+		mknodArgs("a1", "a2", data)
+	case "mknodat":
+		mknodArgs("a2", "a3", data)
+	case "statx":
+		flagsArg("a2", statxFlags, data)
+	case "newfstatat", "fstatat64", "utimensat", "fchmodat2":
+		flagsArg("a3", atFlags, data)
+	case "access":
+		accessMode("a1", data)
+	case "faccessat":
+		accessMode("a2", data)
+	case "faccessat2":
+		accessMode("a2", data)
+		flagsArg("a3", faccessatFlags, data)
+	case "unlinkat":
+		flagsArg("a2", unlinkatFlags, data)
+	case "fanotify_mark":
+		flagsArg("a1", fanotifyMarkFlags, data)
+		fanotifyMask("a2", data)
+	case "setns":
+		nsTypeArg("a1", data)
+	case "epoll_create1":
+		flagsArg("a0", epollCreateFlags, data)
+	case "eventfd2":
+		flagsArg("a1", eventfdFlags, data)
+	case "inotify_init1":
+		flagsArg("a0", inotifyInitFlags, data)
+	}
+
+	if _, found := byteCountSyscalls[syscall.Value()]; found {
+		bytesTransferred(data)
+	}
+	timeoutArg(syscall.Value(), data)
+}
+
+// byteCountSyscalls are the syscalls that return the number of bytes that were
+// transferred when they succeed.
+var byteCountSyscalls = map[string]struct{}{
+	"copy_file_range": {},
+	"getrandom":       {},
+	"pread64":         {},
+	"preadv":          {},
+	"preadv2":         {},
+	"pwrite64":        {},
+	"pwritev":         {},
+	"pwritev2":        {},
+	"read":            {},
+	"readlink":        {},
+	"readlinkat":      {},
+	"readv":           {},
+	"recvfrom":        {},
+	"recvmsg":         {},
+	"sendfile":        {},
+	"sendmsg":         {},
+	"sendto":          {},
+	"splice":          {},
+	"tee":             {},
+	"write":           {},
+	"writev":          {},
+}
+
+// bytesTransferred copies a non-negative exit value to exit_bytes. This
+// distinguishes a byte count from the exit value of syscalls that only return
+// 0 on success. Negative exit values have already been replaced by their
+// errno name so they are not numbers.
+func bytesTransferred(data map[string]Field) {
+	field, found := data["exit"]
+	if !found {
+		return
+	}
+	if n, err := strconv.ParseUint(field.Value(), 10, 64); err == nil {
+		data["exit_bytes"] = newField(strconv.FormatUint(n, 10))
+	}
+}
+
+// ipcGetFlags decodes the flags argument of a SysV IPC get syscall into
+// <key>_flags and <key>_mode.
+func ipcGetFlags(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found {
+		return
+	}
+
+	if flags := v &^ 0777; flags != 0 {
+		data[key+"_flags"] = newField(ipcFlags.format(flags))
+	}
+	data[key+"_mode"] = newField(formatMode(v & 0777))
+}
+
+// protArg decodes the memory protection argument into <key>_prot. PROT_NONE is
+// used when no flags are set.
+func protArg(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found {
+		return
+	}
+
+	prot := "PROT_NONE"
+	if v != 0 {
+		prot = protFlags.format(v)
+	}
+	data[key+"_prot"] = newField(prot)
+}
+
+// epollCreateFlags are the flags accepted by epoll_create1 as defined in
+// include/uapi/linux/eventpoll.h.
+var epollCreateFlags = flagNames{
+	{02000000, "EPOLL_CLOEXEC"},
+}
+
+// eventfdFlags are the flags accepted by eventfd2 as defined in
+// include/linux/eventfd.h.
+var eventfdFlags = flagNames{
+	{1, "EFD_SEMAPHORE"},
+	{02000000, "EFD_CLOEXEC"},
+	{04000, "EFD_NONBLOCK"},
+}
+
+// inotifyInitFlags are the flags accepted by inotify_init1 as defined in
+// include/uapi/linux/inotify.h.
+var inotifyInitFlags = flagNames{
+	{02000000, "IN_CLOEXEC"},
+	{04000, "IN_NONBLOCK"},
+}
+
+// flagsArg decodes a flags argument into <key>_flags using the given names. No
+// field is added when no flags are set.
+func flagsArg(key string, names flagNames, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found || v == 0 {
+		return
+	}
+	data[key+"_flags"] = newField(names.format(v))
+}
+
+// atFlags are the AT_* flags accepted by the *at syscalls as defined in
+// include/uapi/linux/fcntl.h. The value 0x200 has a different meaning for
+// each syscall that accepts it so it is in the syscall specific tables.
+var atFlags = flagNames{
+	{0x100, "AT_SYMLINK_NOFOLLOW"},
+	{0x400, "AT_SYMLINK_FOLLOW"},
+	{0x800, "AT_NO_AUTOMOUNT"},
+	{0x1000, "AT_EMPTY_PATH"},
+}
+
+// statxFlags are the flags accepted by statx.
+var statxFlags = append(atFlags[:len(atFlags):len(atFlags)], flagNames{
+	{0x2000, "AT_STATX_FORCE_SYNC"},
+	{0x4000, "AT_STATX_DONT_SYNC"},
+}...)
+
+// faccessatFlags are the flags accepted by faccessat2.
+var faccessatFlags = append(atFlags[:len(atFlags):len(atFlags)], flagNames{
+	{0x200, "AT_EACCESS"},
+}...)
+
+// accessModes are the permissions checked by access as defined in
+// unistd.h.
+var accessModes = flagNames{
+	{4, "R_OK"},
+	{2, "W_OK"},
+	{1, "X_OK"},
+}
+
+// accessMode decodes the mode argument of access into <key>_mode. A mode of 0
+// (F_OK) only checks that the file exists.
+func accessMode(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found {
+		return
+	}
+	if v == 0 {
+		data[key+"_mode"] = newField("F_OK")
+		return
+	}
+	data[key+"_mode"] = newField(accessModes.format(v))
+}
+
+// unlinkatFlags are the flags accepted by unlinkat.
+var unlinkatFlags = flagNames{
+	{0x200, "AT_REMOVEDIR"},
+}
+
+// fanotifyMarkFlags are the flags accepted by fanotify_mark as defined in
+// include/uapi/linux/fanotify.h.
+var fanotifyMarkFlags = flagNames{
+	{0x001, "FAN_MARK_ADD"},
+	{0x002, "FAN_MARK_REMOVE"},
+	{0x004, "FAN_MARK_DONT_FOLLOW"},
+	{0x008, "FAN_MARK_ONLYDIR"},
+	{0x010, "FAN_MARK_MOUNT"},
+	{0x020, "FAN_MARK_IGNORED_MASK"},
+	{0x040, "FAN_MARK_IGNORED_SURV_MODIFY"},
+	{0x080, "FAN_MARK_FLUSH"},
+	{0x100, "FAN_MARK_FILESYSTEM"},
+	{0x200, "FAN_MARK_EVICTABLE"},
+	{0x400, "FAN_MARK_IGNORE"},
+}
+
+// fanotifyEvents are the event bits of a fanotify mask as defined in
+// include/uapi/linux/fanotify.h.
+var fanotifyEvents = flagNames{
+	{0x00000001, "FAN_ACCESS"},
+	{0x00000002, "FAN_MODIFY"},
+	{0x00000004, "FAN_ATTRIB"},
+	{0x00000008, "FAN_CLOSE_WRITE"},
+	{0x00000010, "FAN_CLOSE_NOWRITE"},
+	{0x00000020, "FAN_OPEN"},
+	{0x00000040, "FAN_MOVED_FROM"},
+	{0x00000080, "FAN_MOVED_TO"},
+	{0x00000100, "FAN_CREATE"},
+	{0x00000200, "FAN_DELETE"},
+	{0x00000400, "FAN_DELETE_SELF"},
+	{0x00000800, "FAN_MOVE_SELF"},
+	{0x00001000, "FAN_OPEN_EXEC"},
+	{0x00004000, "FAN_Q_OVERFLOW"},
+	{0x00008000, "FAN_FS_ERROR"},
+	{0x00010000, "FAN_OPEN_PERM"},
+	{0x00020000, "FAN_ACCESS_PERM"},
+	{0x00040000, "FAN_OPEN_EXEC_PERM"},
+	{0x08000000, "FAN_EVENT_ON_CHILD"},
+	{0x10000000, "FAN_RENAME"},
+	{0x40000000, "FAN_ONDIR"},
+}
+
+// fanotifyMask decodes the event mask argument of fanotify_mark into
+// <key>_mask. On 32-bit architectures the 64-bit mask is split over two
+// arguments, but all of the defined bits are in the low half.
+func fanotifyMask(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found {
+		return
+	}
+	data[key+"_mask"] = newField(fanotifyEvents.format(v))
+}
+
+// namespaceTypes are the namespace flags accepted by setns as defined in
+// include/uapi/linux/sched.h.
+var namespaceTypes = flagNames{
+	{0x00000080, "CLONE_NEWTIME"},
+	{0x00020000, "CLONE_NEWNS"},
+	{0x02000000, "CLONE_NEWCGROUP"},
+	{0x04000000, "CLONE_NEWUTS"},
+	{0x08000000, "CLONE_NEWIPC"},
+	{0x10000000, "CLONE_NEWUSER"},
+	{0x20000000, "CLONE_NEWPID"},
+	{0x40000000, "CLONE_NEWNET"},
+}
+
+// nsTypeArg decodes the nstype argument of setns into <key>_nstype. An nstype
+// of 0 allows joining any type of namespace so it is not decoded.
+func nsTypeArg(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found || v == 0 {
+		return
+	}
+	data[key+"_nstype"] = newField(namespaceTypes.format(v))
+}
+
+// pidArg decodes a pid argument into <key>_target_pid. pid_t is a signed
+// 32-bit integer so negative values (e.g. the process group -1234 or -1 for
+// all processes in kill) are preserved.
+func pidArg(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found {
+		return
+	}
+	data[key+"_target_pid"] = newField(strconv.Itoa(int(int32(uint32(v)))))
+}
+
+// signalArg decodes a signal number argument into <key>_signal.
+func signalArg(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found {
+		return
+	}
+
+	if name := signalName(int(v)); name != "" {
+		data[key+"_signal"] = newField(name)
+	}
+}
+
+// sigprocmaskHowNames are the values of the how argument of sigprocmask.
+var sigprocmaskHowNames = []string{"SIG_BLOCK", "SIG_UNBLOCK", "SIG_SETMASK"}
+
+// sigprocmaskHow decodes the how argument of sigprocmask into <key>_how. The
+// signal mask itself is passed by pointer so it is not contained in the
+// record.
+func sigprocmaskHow(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found || v >= uint64(len(sigprocmaskHowNames)) {
+		return
+	}
+	data[key+"_how"] = newField(sigprocmaskHowNames[v])
+}
+
+// prctlOptions are the options of prctl as defined in
+// include/uapi/linux/prctl.h.
+var prctlOptions = map[uint64]string{
+	1:          "PR_SET_PDEATHSIG",
+	2:          "PR_GET_PDEATHSIG",
+	3:          "PR_GET_DUMPABLE",
+	4:          "PR_SET_DUMPABLE",
+	5:          "PR_GET_UNALIGN",
+	6:          "PR_SET_UNALIGN",
+	7:          "PR_GET_KEEPCAPS",
+	8:          "PR_SET_KEEPCAPS",
+	9:          "PR_GET_FPEMU",
+	10:         "PR_SET_FPEMU",
+	11:         "PR_GET_FPEXC",
+	12:         "PR_SET_FPEXC",
+	13:         "PR_GET_TIMING",
+	14:         "PR_SET_TIMING",
+	15:         "PR_SET_NAME",
+	16:         "PR_GET_NAME",
+	19:         "PR_GET_ENDIAN",
+	20:         "PR_SET_ENDIAN",
+	21:         "PR_GET_SECCOMP",
+	22:         "PR_SET_SECCOMP",
+	23:         "PR_CAPBSET_READ",
+	24:         "PR_CAPBSET_DROP",
+	25:         "PR_GET_TSC",
+	26:         "PR_SET_TSC",
+	27:         "PR_GET_SECUREBITS",
+	28:         "PR_SET_SECUREBITS",
+	29:         "PR_SET_TIMERSLACK",
+	30:         "PR_GET_TIMERSLACK",
+	31:         "PR_TASK_PERF_EVENTS_DISABLE",
+	32:         "PR_TASK_PERF_EVENTS_ENABLE",
+	33:         "PR_MCE_KILL",
+	34:         "PR_MCE_KILL_GET",
+	35:         "PR_SET_MM",
+	36:         "PR_SET_CHILD_SUBREAPER",
+	37:         "PR_GET_CHILD_SUBREAPER",
+	38:         "PR_SET_NO_NEW_PRIVS",
+	39:         "PR_GET_NO_NEW_PRIVS",
+	40:         "PR_GET_TID_ADDRESS",
+	41:         "PR_SET_THP_DISABLE",
+	42:         "PR_GET_THP_DISABLE",
+	43:         "PR_MPX_ENABLE_MANAGEMENT",
+	44:         "PR_MPX_DISABLE_MANAGEMENT",
+	45:         "PR_SET_FP_MODE",
+	46:         "PR_GET_FP_MODE",
+	47:         "PR_CAP_AMBIENT",
+	50:         "PR_SVE_SET_VL",
+	51:         "PR_SVE_GET_VL",
+	52:         "PR_GET_SPECULATION_CTRL",
+	53:         "PR_SET_SPECULATION_CTRL",
+	54:         "PR_PAC_RESET_KEYS",
+	55:         "PR_SET_TAGGED_ADDR_CTRL",
+	56:         "PR_GET_TAGGED_ADDR_CTRL",
+	57:         "PR_SET_IO_FLUSHER",
+	58:         "PR_GET_IO_FLUSHER",
+	59:         "PR_SET_SYSCALL_USER_DISPATCH",
+	0x59616d61: "PR_SET_PTRACER",
+}
+
+// prctlOption decodes the option argument of prctl into <key>_option.
+func prctlOption(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found {
+		return
+	}
+	if name, found := prctlOptions[v]; found {
+		data[key+"_option"] = newField(name)
+	}
+}
+
+// sockoptLevels are the socket option levels of setsockopt and getsockopt as
+// defined in include/linux/socket.h and include/uapi/linux/in.h.
+var sockoptLevels = map[uint64]string{
+	0:   "IPPROTO_IP",
+	1:   "SOL_SOCKET",
+	6:   "IPPROTO_TCP",
+	17:  "IPPROTO_UDP",
+	41:  "IPPROTO_IPV6",
+	255: "IPPROTO_RAW",
+	263: "SOL_PACKET",
+	270: "SOL_NETLINK",
+	279: "SOL_ALG",
+}
+
+// sockoptNames are the socket option names of each level. The SOL_SOCKET
+// values are those of include/uapi/asm-generic/socket.h, which most
+// architectures use.
+var sockoptNames = map[uint64]map[uint64]string{
+	0: {
+		1:  "IP_TOS",
+		2:  "IP_TTL",
+		3:  "IP_HDRINCL",
+		4:  "IP_OPTIONS",
+		8:  "IP_PKTINFO",
+		10: "IP_MTU_DISCOVER",
+		15: "IP_FREEBIND",
+		19: "IP_TRANSPARENT",
+		32: "IP_MULTICAST_IF",
+		33: "IP_MULTICAST_TTL",
+		34: "IP_MULTICAST_LOOP",
+		35: "IP_ADD_MEMBERSHIP",
+		36: "IP_DROP_MEMBERSHIP",
+	},
+	1: {
+		1:  "SO_DEBUG",
+		2:  "SO_REUSEADDR",
+		3:  "SO_TYPE",
+		4:  "SO_ERROR",
+		5:  "SO_DONTROUTE",
+		6:  "SO_BROADCAST",
+		7:  "SO_SNDBUF",
+		8:  "SO_RCVBUF",
+		9:  "SO_KEEPALIVE",
+		10: "SO_OOBINLINE",
+		12: "SO_PRIORITY",
+		13: "SO_LINGER",
+		15: "SO_REUSEPORT",
+		16: "SO_PASSCRED",
+		17: "SO_PEERCRED",
+		18: "SO_RCVLOWAT",
+		19: "SO_SNDLOWAT",
+		20: "SO_RCVTIMEO",
+		21: "SO_SNDTIMEO",
+		25: "SO_BINDTODEVICE",
+		26: "SO_ATTACH_FILTER",
+		27: "SO_DETACH_FILTER",
+		32: "SO_SNDBUFFORCE",
+		33: "SO_RCVBUFFORCE",
+		36: "SO_MARK",
+		50: "SO_ATTACH_BPF",
+	},
+	6: {
+		1:  "TCP_NODELAY",
+		2:  "TCP_MAXSEG",
+		3:  "TCP_CORK",
+		4:  "TCP_KEEPIDLE",
+		5:  "TCP_KEEPINTVL",
+		6:  "TCP_KEEPCNT",
+		9:  "TCP_DEFER_ACCEPT",
+		13: "TCP_CONGESTION",
+		18: "TCP_USER_TIMEOUT",
+		23: "TCP_FASTOPEN",
+		31: "TCP_ULP",
+	},
+	41: {
+		16: "IPV6_UNICAST_HOPS",
+		26: "IPV6_V6ONLY",
+		49: "IPV6_RECVPKTINFO",
+		75: "IPV6_TRANSPARENT",
+	},
+}
+
+// sockoptArgs decodes the level and optname arguments of setsockopt and
+// getsockopt into a1_level and a2_optname. a2_optname is only added when the
+// level is known because option numbers are reused across levels.
+func sockoptArgs(data map[string]Field) {
+	level, found := syscallArg(data, "a1")
+	if !found {
+		return
+	}
+	name, found := sockoptLevels[level]
+	if !found {
+		return
+	}
+	data["a1_level"] = newField(name)
+
+	if opt, found := syscallArg(data, "a2"); found {
+		if name, found := sockoptNames[level][opt]; found {
+			data["a2_optname"] = newField(name)
+		}
+	}
+}
+
+// mmapFlags decodes the flags argument of mmap into <key>_flags. The mapping
+// type (e.g. MAP_PRIVATE) is listed first.
+func mmapFlags(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found || v == 0 {
+		return
+	}
+
+	var flags []string
+	if typ := mmapTypes[v&0x03]; typ != "" {
+		flags = append(flags, typ)
+	}
+	if rest := v &^ 0x03; rest != 0 {
+		flags = append(flags, mmapFlagNames.format(rest))
+	}
+	data[key+"_flags"] = newField(strings.Join(flags, "|"))
+}
+
+// mqOpenArgs decodes the oflag and mode arguments of mq_open into a1_flags
+// and a2_mode. The mode is only meaningful when O_CREAT is set.
+func mqOpenArgs(data map[string]Field) {
+	oflag, found := syscallArg(data, "a1")
+	if !found {
+		return
+	}
+
+	access := oflag & 03
+	if access >= uint64(len(openAccessModes)) {
+		return
+	}
+	flags := openAccessModes[access]
+	if rest := oflag &^ 03; rest != 0 {
+		flags += "|" + mqOpenFlags.format(rest)
+	}
+	data["a1_flags"] = newField(flags)
+
+	if oflag&00100 == 0 {
+		return
+	}
+	if mode, found := syscallArg(data, "a2"); found {
+		data["a2_mode"] = newField(formatMode(mode & 07777))
+	}
+}
+
+// umaskArg decodes the mask argument of umask into <key>_mask and the
+// permissions that it leaves for new files and directories into
+// <key>_permissions (in the format of umask -S, e.g. u=rwx,g=rx,o=rx for 022).
+func umaskArg(key string, data map[string]Field) {
+	v, found := syscallArg(data, key)
+	if !found {
+		return
+	}
+
+	mask := v & 0777
+	perms := 0777 &^ mask
+	classes := make([]string, 0, 3)
+	for i, class := range []string{"u", "g", "o"} {
+		bits := perms >> uint(6-3*i)
+		s := class + "="
+		for j, p := range "rwx" {
+			if bits&(4>>uint(j)) != 0 {
+				s += string(p)
+			}
+		}
+		classes = append(classes, s)
+	}
+	data[key+"_mask"] = newField(formatMode(mask))
+	data[key+"_permissions"] = newField(strings.Join(classes, ","))
+}
+
+// fileTypes are the file type bits of a mode as defined in
+// include/uapi/linux/stat.h.
+var fileTypes = map[uint64]string{
+	0140000: "S_IFSOCK",
+	0120000: "S_IFLNK",
+	0100000: "S_IFREG",
+	0060000: "S_IFBLK",
+	0040000: "S_IFDIR",
+	0020000: "S_IFCHR",
+	0010000: "S_IFIFO",
+}
+
+// mknodArgs decodes the mode argument of mknod into <modeKey>_mode (e.g.
+// S_IFCHR|0620) and, for character and block devices, the dev argument into
+// <devKey>_dev. The device is formatted as major:minor in hex like the rdev of
+// PATH records.
+func mknodArgs(modeKey, devKey string, data map[string]Field) {
+	mode, found := syscallArg(data, modeKey)
+	if !found {
+		return
+	}
+
+	fileType := mode & 0170000
+	perm := formatMode(mode & 07777)
+	if name, found := fileTypes[fileType]; found {
+		perm = name + "|" + perm
+	}
+	data[modeKey+"_mode"] = newField(perm)
+
+	if fileType != 0020000 && fileType != 0060000 {
+		return
+	}
+	if dev, found := syscallArg(data, devKey); found {
+		// Decode a dev_t as encoded by glibc's makedev.
+		major := (dev>>8)&0xfff | (dev>>32)&^0xfff
+		minor := dev&0xff | (dev>>12)&^0xff
+		data[devKey+"_dev"] = newField(fmt.Sprintf("%02x:%02x", major, minor))
+	}
+}
+
+// formatMode formats a permission mode as an octal number with a leading zero.
+func formatMode(mode uint64) string {
+	return "0" + strconv.FormatUint(mode, 8)
+}
+
+// syscallArgNames are the names of the first four arguments of common
+// syscalls as declared by their SYSCALL_DEFINE in the kernel source. Only the
+// first four arguments are included because audit logs a0 to a3.
+var syscallArgNames = map[string][]string{
+	"accept":        {"fd", "upeer_sockaddr", "upeer_addrlen"},
+	"accept4":       {"fd", "upeer_sockaddr", "upeer_addrlen", "flags"},
+	"bind":          {"fd", "umyaddr", "addrlen"},
+	"chdir":         {"filename"},
+	"chmod":         {"filename", "mode"},
+	"chown":         {"filename", "user", "group"},
+	"chroot":        {"filename"},
+	"clone":         {"clone_flags", "newsp", "parent_tidptr", "child_tidptr"},
+	"close":         {"fd"},
+	"connect":       {"fd", "uservaddr", "addrlen"},
+	"creat":         {"pathname", "mode"},
+	"delete_module": {"name_user", "flags"},
+	"dup2":          {"oldfd", "newfd"},
+	"execve":        {"filename", "argv", "envp"},
+	"execveat":      {"fd", "filename", "argv", "envp"},
+	"fchmod":        {"fd", "mode"},
+	"fchmodat":      {"dfd", "filename", "mode"},
+	"fchown":        {"fd", "user", "group"},
+	"fchownat":      {"dfd", "filename", "user", "group"},
+	"finit_module":  {"fd", "uargs", "flags"},
+	"ftruncate":     {"fd", "length"},
+	"init_module":   {"umod", "len", "uargs"},
+	"kill":          {"pid", "sig"},
+	"lchown":        {"filename", "user", "group"},
+	"link":          {"oldname", "newname"},
+	"linkat":        {"olddfd", "oldname", "newdfd", "newname"},
+	"mkdir":         {"pathname", "mode"},
+	"mkdirat":       {"dfd", "pathname", "mode"},
+	"mknod":         {"filename", "mode", "dev"},
+	"mknodat":       {"dfd", "filename", "mode", "dev"},
+	"mmap":          {"addr", "len", "prot", "flags"},
+	"mount":         {"dev_name", "dir_name", "type", "flags"},
+	"mprotect":      {"start", "len", "prot"},
+	"open":          {"filename", "flags", "mode"},
+	"openat":        {"dfd", "filename", "flags", "mode"},
+	"ptrace":        {"request", "pid", "addr", "data"},
+	"read":          {"fd", "buf", "count"},
+	"rename":        {"oldname", "newname"},
+	"renameat":      {"olddfd", "oldname", "newdfd", "newname"},
+	"renameat2":     {"olddfd", "oldname", "newdfd", "newname"},
+	"rmdir":         {"pathname"},
+	"setgid":        {"gid"},
+	"setuid":        {"uid"},
+	"socket":        {"family", "type", "protocol"},
+	"symlink":       {"oldname", "newname"},
+	"symlinkat":     {"oldname", "newdfd", "newname"},
+	"tgkill":        {"tgid", "pid", "sig"},
+	"tkill":         {"pid", "sig"},
+	"truncate":      {"path", "length"},
+	"umount2":       {"name", "flags"},
+	"unlink":        {"pathname"},
+	"unlinkat":      {"dfd", "pathname", "flag"},
+	"write":         {"fd", "buf", "count"},
+}
+
+// SyscallArgNames returns the names of the arguments recorded as a0 to a3 for
+// the syscall of a SYSCALL message (e.g. dfd, filename, flags, and mode for
+// openat). nil is returned if the message is not a SYSCALL record or the
+// syscall is not known. The returned slice is a copy that may be modified by
+// the caller.
+func (m *AuditMessage) SyscallArgNames() []string {
+	if m.RecordType != AUDIT_SYSCALL {
+		return nil
+	}
+
+	data, err := m.Data()
+	if err != nil {
+		return nil
+	}
+	names, found := syscallArgNames[data["syscall"]]
+	if !found {
+		return nil
+	}
+	return append([]string(nil), names...)
+}
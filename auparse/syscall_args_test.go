@@ -0,0 +1,442 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyscallArgsIPC(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:584): arch=c000003e `
+	tests := []struct {
+		name     string
+		args     string
+		flagsKey string
+		flags    string
+		modeKey  string
+		mode     string
+	}{
+		{"shmget", `syscall=29 success=yes exit=5 a0=0 a1=1000 a2=3a4 a3=0 exe="/usr/bin/ipc"`, "a2_flags", "IPC_CREAT", "a2_mode", "0644"},
+		{"shmget", `syscall=29 success=yes exit=5 a0=1e240 a1=1000 a2=780 a3=0 exe="/usr/bin/ipc"`, "a2_flags", "IPC_CREAT|IPC_EXCL", "a2_mode", "0600"},
+		{"msgget", `syscall=68 success=yes exit=2 a0=0 a1=1b6 a2=0 a3=0 exe="/usr/bin/ipc"`, "a1_flags", "", "a1_mode", "0666"},
+		{"mq_open", `syscall=240 success=yes exit=3 a0=7ffd5a1c a1=842 a2=180 a3=0 exe="/usr/bin/ipc"`, "a1_flags", "O_RDWR|O_CREAT|O_NONBLOCK", "a2_mode", "0600"},
+		{"mq_open", `syscall=240 success=yes exit=3 a0=7ffd5a1c a1=0 a2=7ffd a3=0 exe="/usr/bin/ipc"`, "a1_flags", "O_RDONLY", "a2_mode", ""},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(header + tc.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.name, data["syscall"], tc.args)
+		assert.Equal(t, tc.flags, data[tc.flagsKey], tc.args)
+		assert.Equal(t, tc.mode, data[tc.modeKey], tc.args)
+	}
+}
+
+func TestFlagNamesFormat(t *testing.T) {
+	assert.Equal(t, "IPC_CREAT", ipcFlags.format(01000))
+	assert.Equal(t, "IPC_EXCL|0x4000", ipcFlags.format(02000|040000))
+	assert.Equal(t, "0x0", ipcFlags.format(0))
+}
+
+func TestSyscallArgsProt(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:600): arch=c000003e `
+	tests := []struct {
+		args string
+		prot string
+	}{
+		// RWX mprotect.
+		{`syscall=10 success=yes exit=0 a0=7f3c0d1e2000 a1=1000 a2=7 a3=0`, "PROT_READ|PROT_WRITE|PROT_EXEC"},
+		{`syscall=9 success=yes exit=139894563135488 a0=0 a1=1000 a2=5 a3=22`, "PROT_READ|PROT_EXEC"},
+		{`syscall=329 success=yes exit=0 a0=7f3c0d1e2000 a1=1000 a2=0 a3=1`, "PROT_NONE"},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(header + tc.args + ` exe="/usr/bin/jit"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.prot, data["a2_prot"], tc.args)
+	}
+}
+
+func TestSyscallArgsSignal(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:610): arch=c000003e `
+	tests := []struct {
+		args   string
+		key    string
+		signal string
+	}{
+		{`syscall=13 success=no exit=-22 a0=9 a1=7ffd5a1c a2=0 a3=8`, "a0_signal", "SIGKILL"},
+		{`syscall=13 success=yes exit=0 a0=f a1=7ffd5a1c a2=0 a3=8`, "a0_signal", "SIGTERM"},
+		{`syscall=14 success=yes exit=0 a0=0 a1=7ffd5a1c a2=7ffd5a2c a3=8`, "a0_how", "SIG_BLOCK"},
+		{`syscall=14 success=yes exit=0 a0=2 a1=7ffd5a1c a2=0 a3=8`, "a0_how", "SIG_SETMASK"},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(header + tc.args + ` exe="/usr/bin/sig"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.signal, data[tc.key], tc.args)
+	}
+}
+
+func TestSyscallArgsFdFlags(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:586): arch=c000003e `
+	tests := []struct {
+		name  string
+		args  string
+		key   string
+		flags string
+	}{
+		{"eventfd2", `syscall=290 success=yes exit=3 a0=0 a1=80000 a2=0 a3=0`, "a1_flags", "EFD_CLOEXEC"},
+		{"eventfd2", `syscall=290 success=yes exit=3 a0=0 a1=80801 a2=0 a3=0`, "a1_flags", "EFD_SEMAPHORE|EFD_CLOEXEC|EFD_NONBLOCK"},
+		{"eventfd2", `syscall=290 success=yes exit=3 a0=0 a1=0 a2=0 a3=0`, "a1_flags", ""},
+		{"epoll_create1", `syscall=291 success=yes exit=4 a0=80000 a1=0 a2=0 a3=0`, "a0_flags", "EPOLL_CLOEXEC"},
+		{"inotify_init1", `syscall=294 success=yes exit=5 a0=800 a1=0 a2=0 a3=0`, "a0_flags", "IN_NONBLOCK"},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(header + tc.args + ` exe="/usr/bin/app"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.name, data["syscall"], tc.args)
+		assert.Equal(t, tc.flags, data[tc.key], tc.args)
+	}
+}
+
+func TestSyscallArgsSetns(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:587): arch=c000003e syscall=308 success=yes exit=0 a0=3 `
+	for args, nstype := range map[string]string{
+		"a1=40000000 a2=0 a3=0": "CLONE_NEWNET",
+		"a1=60000000 a2=0 a3=0": "CLONE_NEWPID|CLONE_NEWNET",
+		"a1=0 a2=0 a3=0":        "",
+	} {
+		msg, err := ParseLogLine(header + args + ` exe="/usr/bin/nsenter"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "setns", data["syscall"])
+		assert.Equal(t, nstype, data["a1_nstype"], args)
+	}
+}
+
+func TestSyscallBytes(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:588): arch=c000003e `
+	for args, bytes := range map[string]string{
+		`syscall=0 success=yes exit=4096 a0=3 a1=7ffd5a1c a2=1000 a3=0`: "4096",
+		`syscall=0 success=yes exit=0 a0=3 a1=7ffd5a1c a2=1000 a3=0`:    "0",
+		`syscall=1 success=no exit=-9 a0=3 a1=7ffd5a1c a2=1000 a3=0`:    "",
+		`syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffd a2=0 a3=0`:  "",
+		`syscall=44 success=yes exit=120 a0=5 a1=7ffd5a1c a2=78 a3=0`:   "120",
+	} {
+		msg, err := ParseLogLine(header + args + ` exe="/usr/bin/cat"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, bytes, data["exit_bytes"], args)
+	}
+}
+
+func TestSyscallArgsFanotifyMark(t *testing.T) {
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1610903553.686:589): arch=c000003e syscall=301 success=yes exit=0 a0=3 a1=111 a2=1000 a3=ffffff9c items=1 ppid=1 pid=2240 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=3 comm="edr" exe="/usr/bin/edr" key=(null)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "fanotify_mark", data["syscall"])
+	assert.Equal(t, "FAN_MARK_ADD|FAN_MARK_MOUNT|FAN_MARK_FILESYSTEM", data["a1_flags"])
+	assert.Equal(t, "FAN_OPEN_EXEC", data["a2_mask"])
+}
+
+func TestSyscallArgsAtFlags(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:590): arch=c000003e `
+	tests := []struct {
+		name  string
+		args  string
+		key   string
+		flags string
+	}{
+		{"statx", `syscall=332 success=yes exit=0 a0=ffffff9c a1=7ffd5a1c a2=100 a3=fff`, "a2_flags", "AT_SYMLINK_NOFOLLOW"},
+		{"statx", `syscall=332 success=yes exit=0 a0=3 a1=7ffd5a1c a2=3000 a3=fff`, "a2_flags", "AT_EMPTY_PATH|AT_STATX_FORCE_SYNC"},
+		{"newfstatat", `syscall=262 success=yes exit=0 a0=ffffff9c a1=7ffd5a1c a2=7ffd5b00 a3=100`, "a3_flags", "AT_SYMLINK_NOFOLLOW"},
+		{"unlinkat", `syscall=263 success=yes exit=0 a0=ffffff9c a1=7ffd5a1c a2=200 a3=0`, "a2_flags", "AT_REMOVEDIR"},
+		{"utimensat", `syscall=280 success=yes exit=0 a0=ffffff9c a1=7ffd5a1c a2=0 a3=100`, "a3_flags", "AT_SYMLINK_NOFOLLOW"},
+		{"unlinkat", `syscall=263 success=yes exit=0 a0=ffffff9c a1=7ffd5a1c a2=0 a3=0`, "a2_flags", ""},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(header + tc.args + ` exe="/usr/bin/stat"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.name, data["syscall"], tc.args)
+		assert.Equal(t, tc.flags, data[tc.key], tc.args)
+	}
+}
+
+func TestSyscallArgsAccessMode(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:592): arch=c000003e `
+	tests := []struct {
+		args string
+		key  string
+		mode string
+	}{
+		{`syscall=269 success=no exit=-13 a0=ffffff9c a1=7ffd5a1c a2=3 a3=0`, "a2_mode", "W_OK|X_OK"},
+		{`syscall=21 success=yes exit=0 a0=7ffd5a1c a1=4 a2=0 a3=0`, "a1_mode", "R_OK"},
+		{`syscall=21 success=yes exit=0 a0=7ffd5a1c a1=0 a2=0 a3=0`, "a1_mode", "F_OK"},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(header + tc.args + ` exe="/usr/bin/test"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.mode, data[tc.key], tc.args)
+		// The unprefixed mode is left for the mode of PATH records.
+		assert.NotContains(t, data, "mode", tc.args)
+	}
+}
+
+func TestSyscallArgsUmask(t *testing.T) {
+	for mask, expected := range map[string]string{
+		"12":  "u=rwx,g=rx,o=rx",
+		"3f":  "u=rwx,g=,o=",
+		"0":   "u=rwx,g=rwx,o=rwx",
+		"1ff": "u=,g=,o=",
+	} {
+		msg, err := ParseLogLine(`type=SYSCALL msg=audit(1610903553.686:593): arch=c000003e syscall=95 success=yes exit=18 a0=` + mask + ` a1=0 a2=0 a3=0 exe="/bin/bash"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "umask", data["syscall"])
+		assert.Equal(t, expected, data["a0_permissions"], mask)
+		if mask == "12" {
+			assert.Equal(t, "022", data["a0_mask"])
+		}
+	}
+}
+
+func TestSyscallArgsMknod(t *testing.T) {
+	// mknod("/dev/tty1", S_IFCHR|0620, makedev(4, 1))
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1610903553.686:600): arch=c000003e syscall=133 success=yes exit=0 a0=7ffd2c1e9f12 a1=2190 a2=401 a3=0 exe="/usr/bin/mknod"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "mknod", data["syscall"])
+	assert.Equal(t, "S_IFCHR|0620", data["a1_mode"])
+	assert.Equal(t, "04:01", data["a2_dev"])
+
+	// mknodat(AT_FDCWD, "/tmp/fifo", S_IFIFO|0644, 0) has no device.
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1610903553.686:601): arch=c000003e syscall=259 success=yes exit=0 a0=ffffff9c a1=7ffd2c1e9f12 a2=11a4 a3=0 exe="/usr/bin/mkfifo"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "mknodat", data["syscall"])
+	assert.Equal(t, "S_IFIFO|0644", data["a2_mode"])
+	assert.NotContains(t, data, "a3_dev")
+
+	// makedev(259, 70000) needs the high bits of the dev_t.
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1610903553.686:602): arch=c000003e syscall=133 success=yes exit=0 a0=7ffd2c1e9f12 a1=61b0 a2=11110370 a3=0 exe="/usr/bin/mknod"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "S_IFBLK|0660", data["a1_mode"])
+	assert.Equal(t, "103:11170", data["a2_dev"])
+}
+
+func TestSyscallArgsSockopt(t *testing.T) {
+	// setsockopt(3, SOL_SOCKET, SO_REUSEADDR, ...)
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1610903553.686:603): arch=c000003e syscall=54 success=yes exit=0 a0=3 a1=1 a2=2 a3=7ffd2c1e9f1c exe="/usr/sbin/nginx"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "setsockopt", data["syscall"])
+	assert.Equal(t, "SOL_SOCKET", data["a1_level"])
+	assert.Equal(t, "SO_REUSEADDR", data["a2_optname"])
+
+	// getsockopt(3, IPPROTO_IP, IP_TRANSPARENT, ...)
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1610903553.686:604): arch=c000003e syscall=55 success=yes exit=0 a0=3 a1=0 a2=13 a3=7ffd2c1e9f1c exe="/usr/sbin/proxy"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "getsockopt", data["syscall"])
+	assert.Equal(t, "IPPROTO_IP", data["a1_level"])
+	assert.Equal(t, "IP_TRANSPARENT", data["a2_optname"])
+}
+
+func TestSyscallArgsMmapFlags(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:594): arch=c000003e `
+	tests := []struct {
+		args  string
+		prot  string
+		flags string
+	}{
+		{`syscall=9 success=yes exit=140735 a0=0 a1=1000 a2=3 a3=22`, "PROT_READ|PROT_WRITE", "MAP_PRIVATE|MAP_ANONYMOUS"},
+		{`syscall=9 success=yes exit=140735 a0=7f00 a1=1000 a2=7 a3=32`, "PROT_READ|PROT_WRITE|PROT_EXEC", "MAP_PRIVATE|MAP_FIXED|MAP_ANONYMOUS"},
+		{`syscall=9 success=yes exit=140735 a0=0 a1=1000 a2=1 a3=1`, "PROT_READ", "MAP_SHARED"},
+		{`syscall=25 success=yes exit=140735 a0=7f00 a1=1000 a2=2000 a3=1`, "", "MREMAP_MAYMOVE"},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(header + tc.args + ` exe="/usr/bin/test"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.prot, data["a2_prot"], tc.args)
+		assert.Equal(t, tc.flags, data["a3_flags"], tc.args)
+	}
+}
+
+func TestSyscallArgsTargetPID(t *testing.T) {
+	const header = `type=SYSCALL msg=audit(1610903553.686:595): arch=c000003e `
+	tests := []struct {
+		args string
+		key  string
+		pid  string
+	}{
+		{`syscall=62 success=yes exit=0 a0=1a2b a1=9 a2=0 a3=0`, "a0_target_pid", "6699"},
+		{`syscall=62 success=yes exit=0 a0=ffffffffffffffff a1=f a2=0 a3=0`, "a0_target_pid", "-1"},
+		{`syscall=234 success=yes exit=0 a0=1a2b a1=1a2c a2=f a3=0`, "a1_target_pid", "6700"},
+		{`syscall=101 success=yes exit=0 a0=10 a1=1a2b a2=0 a3=0`, "a1_target_pid", "6699"},
+		{`syscall=203 success=yes exit=0 a0=0 a1=80 a2=7ffd a3=0`, "a0_target_pid", "0"},
+	}
+
+	for _, tc := range tests {
+		msg, err := ParseLogLine(header + tc.args + ` exe="/usr/bin/test"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, tc.pid, data[tc.key], tc.args)
+	}
+}
+
+func TestSyscallArgsPrctl(t *testing.T) {
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1610903553.686:591): arch=c000003e syscall=157 success=yes exit=0 a0=26 a1=1 a2=0 a3=0 items=0 ppid=1 pid=3120 auid=4294967295 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=4294967295 comm="systemd" exe="/usr/lib/systemd/systemd" key=(null)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "prctl", data["syscall"])
+	assert.Equal(t, "PR_SET_NO_NEW_PRIVS", data["a0_option"])
+}
+
+func TestSyscallArgNames(t *testing.T) {
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1445): arch=c000003e syscall=257 success=yes exit=3 a0=ffffff9c a1=7ffc3a2e7711 a2=241 a3=1b6 items=2 ppid=1500 pid=1532 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=2 comm="tee" exe="/usr/bin/tee" key="hosts"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"dfd", "filename", "flags", "mode"}, msg.SyscallArgNames())
+
+	// The result is a copy.
+	msg.SyscallArgNames()[0] = "fd"
+	assert.Equal(t, "dfd", msg.SyscallArgNames()[0])
+
+	msg, err = ParseLogLine(`type=CWD msg=audit(1611352422.102:1445): cwd="/root"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, msg.SyscallArgNames())
+}
@@ -0,0 +1,121 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import "strconv"
+
+// TimeArg is the kind of a time related syscall argument.
+type TimeArg uint8
+
+const (
+	// TimespecPointer is a pointer to a struct timespec. The time itself is
+	// not contained in the record.
+	TimespecPointer TimeArg = iota + 1
+	// TimevalPointer is a pointer to a struct timeval. The time itself is not
+	// contained in the record.
+	TimevalPointer
+	// TimeoutMillis is a timeout in milliseconds. A negative timeout waits
+	// forever.
+	TimeoutMillis
+	// TimeoutSeconds is a timeout in seconds.
+	TimeoutSeconds
+)
+
+var timeArgNames = map[TimeArg]string{
+	TimespecPointer: "timespec_pointer",
+	TimevalPointer:  "timeval_pointer",
+	TimeoutMillis:   "timeout_ms",
+	TimeoutSeconds:  "timeout_s",
+}
+
+func (t TimeArg) String() string {
+	if name, found := timeArgNames[t]; found {
+		return name
+	}
+	return "unknown"
+}
+
+// syscallTimeArgs are the time related arguments of syscalls that are among
+// a0 to a3. Arguments after a3 (e.g. the timeout of select) are not logged.
+var syscallTimeArgs = map[string]map[string]TimeArg{
+	"alarm":           {"a0": TimeoutSeconds},
+	"clock_gettime":   {"a1": TimespecPointer},
+	"clock_nanosleep": {"a2": TimespecPointer, "a3": TimespecPointer},
+	"clock_settime":   {"a1": TimespecPointer},
+	"epoll_pwait":     {"a3": TimeoutMillis},
+	"epoll_wait":      {"a3": TimeoutMillis},
+	"futex":           {"a3": TimespecPointer},
+	"gettimeofday":    {"a0": TimevalPointer},
+	"nanosleep":       {"a0": TimespecPointer, "a1": TimespecPointer},
+	"poll":            {"a2": TimeoutMillis},
+	"ppoll":           {"a2": TimespecPointer},
+	"rt_sigtimedwait": {"a2": TimespecPointer},
+	"semtimedop":      {"a3": TimespecPointer},
+	"settimeofday":    {"a0": TimevalPointer},
+	"utimes":          {"a1": TimevalPointer},
+}
+
+// SyscallTimeArgs returns the kinds of the time related arguments (a0 to a3)
+// of the syscall of a SYSCALL message keyed by argument (e.g. a0 and a1 are
+// TimespecPointers for nanosleep). Pointer arguments are addresses and not
+// times. Timeouts that are passed by value are also decoded into
+// <arg>_timeout (e.g. a2_timeout for poll). nil is returned if the syscall has
+// no known time related arguments. The returned map is a copy that may be
+// modified by the caller.
+func (m *AuditMessage) SyscallTimeArgs() map[string]TimeArg {
+	if m.RecordType != AUDIT_SYSCALL {
+		return nil
+	}
+
+	data, err := m.Data()
+	if err != nil {
+		return nil
+	}
+	args, found := syscallTimeArgs[data["syscall"]]
+	if !found {
+		return nil
+	}
+	out := make(map[string]TimeArg, len(args))
+	for k, v := range args {
+		out[k] = v
+	}
+	return out
+}
+
+// timeoutArg decodes a timeout that is passed by value into <key>_timeout (e.g.
+// 500ms, 30s, or infinite).
+func timeoutArg(syscall string, data map[string]Field) {
+	for key, kind := range syscallTimeArgs[syscall] {
+		v, found := syscallArg(data, key)
+		if !found {
+			continue
+		}
+
+		timeout := int(int32(uint32(v)))
+		switch kind {
+		case TimeoutMillis:
+			if timeout < 0 {
+				data[key+"_timeout"] = newField("infinite")
+			} else {
+				data[key+"_timeout"] = newField(strconv.Itoa(timeout) + "ms")
+			}
+		case TimeoutSeconds:
+			data[key+"_timeout"] = newField(strconv.FormatUint(uint64(uint32(v)), 10) + "s")
+		}
+	}
+}
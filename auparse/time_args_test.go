@@ -0,0 +1,65 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyscallTimeArgs(t *testing.T) {
+	// nanosleep only logs the addresses of the requested and remaining time.
+	msg, err := ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1450): arch=c000003e syscall=35 success=yes exit=0 a0=7ffd3c5a2e40 a1=0 a2=0 a3=0 items=0 ppid=1500 pid=1550 auid=1000 uid=1000 gid=1000 exe="/usr/bin/sleep"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "nanosleep", data["syscall"])
+	assert.Equal(t, map[string]TimeArg{"a0": TimespecPointer, "a1": TimespecPointer}, msg.SyscallTimeArgs())
+	assert.Equal(t, "7ffd3c5a2e40", data["a0"])
+	assert.NotContains(t, data, "a0_timeout")
+	assert.Equal(t, "timespec_pointer", TimespecPointer.String())
+
+	// The result is a copy.
+	delete(msg.SyscallTimeArgs(), "a0")
+	assert.Contains(t, msg.SyscallTimeArgs(), "a0")
+
+	for a2, timeout := range map[string]string{"1f4": "500ms", "0": "0ms", "ffffffff": "infinite"} {
+		msg, err = ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1451): arch=c000003e syscall=7 success=yes exit=0 a0=7ffd3c5a2e40 a1=1 a2=` + a2 + ` a3=0 exe="/usr/bin/ssh"`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err = msg.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, timeout, data["a2_timeout"], a2)
+		assert.Equal(t, map[string]TimeArg{"a2": TimeoutMillis}, msg.SyscallTimeArgs())
+	}
+
+	msg, err = ParseLogLine(`type=SYSCALL msg=audit(1611352422.102:1452): arch=c000003e syscall=0 success=yes exit=0 a0=3 a1=7ffd a2=1000 a3=0 exe="/usr/bin/cat"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, msg.SyscallTimeArgs())
+}
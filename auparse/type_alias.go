@@ -0,0 +1,65 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"strings"
+	"sync"
+)
+
+var typeAliases = struct {
+	sync.RWMutex
+	names map[AuditMessageType]string
+	types map[string]AuditMessageType
+}{
+	names: map[AuditMessageType]string{},
+	types: map[string]AuditMessageType{},
+}
+
+// RegisterTypeAlias registers a display name for a record type. The name is
+// returned by String (and therefore used by MarshalText and ToMapStr) in
+// place of the kernel's name, and it is accepted by GetAuditMessageType.
+// Registering an empty name removes the alias. It is safe for concurrent use.
+func RegisterTypeAlias(typ AuditMessageType, name string) {
+	typeAliases.Lock()
+	defer typeAliases.Unlock()
+
+	if old, found := typeAliases.names[typ]; found {
+		delete(typeAliases.types, strings.ToUpper(old))
+		delete(typeAliases.names, typ)
+	}
+	if name == "" {
+		return
+	}
+	typeAliases.names[typ] = name
+	typeAliases.types[strings.ToUpper(name)] = typ
+}
+
+func typeAlias(t AuditMessageType) (string, bool) {
+	typeAliases.RLock()
+	defer typeAliases.RUnlock()
+	name, found := typeAliases.names[t]
+	return name, found
+}
+
+func typeFromAlias(name string) (AuditMessageType, bool) {
+	typeAliases.RLock()
+	defer typeAliases.RUnlock()
+	typ, found := typeAliases.types[name]
+	return typ, found
+}
@@ -0,0 +1,47 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterTypeAlias(t *testing.T) {
+	RegisterTypeAlias(AUDIT_USER_LOGIN, "Anmeldung")
+	defer RegisterTypeAlias(AUDIT_USER_LOGIN, "")
+
+	msg, err := ParseLogLine(`type=USER_LOGIN msg=audit(1610903553.686:584): pid=2240 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.2 terminal=ssh res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, AUDIT_USER_LOGIN, msg.RecordType)
+	assert.Equal(t, "Anmeldung", msg.ToMapStr()["record_type"])
+	assert.Equal(t, "USER_LOGIN", msg.RecordType.CanonicalName())
+
+	typ, err := GetAuditMessageType("anmeldung")
+	if assert.NoError(t, err) {
+		assert.Equal(t, AUDIT_USER_LOGIN, typ)
+	}
+
+	RegisterTypeAlias(AUDIT_USER_LOGIN, "")
+	assert.Equal(t, "USER_LOGIN", AUDIT_USER_LOGIN.String())
+	_, err = GetAuditMessageType("anmeldung")
+	assert.Error(t, err)
+}
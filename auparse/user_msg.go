@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// UserMessage is the sub-message that userspace records (e.g. USER_AUTH or
+// USER_START) wrap in msg='...'.
+type UserMessage struct {
+	Op        string            // Operation (e.g. PAM:authentication).
+	Subsystem string            // Subsystem that performed the operation (e.g. PAM).
+	Operation string            // Operation without the subsystem (e.g. authentication).
+	Grantors  []string          // PAM modules that granted the operation (e.g. pam_unix).
+	Fields    map[string]string // Enriched values of all fields in the sub-message.
+}
+
+// isUserMessageType returns true if typ is in one of the ranges of record
+// types used by userspace programs (1100-1199 and 2100-2999).
+func isUserMessageType(typ AuditMessageType) bool {
+	return (typ >= AUDIT_USER_AUTH && typ <= AUDIT_LAST_USER_MSG) ||
+		(typ >= AUDIT_ANOM_LOGIN_FAILURES && typ <= AUDIT_LAST_USER_MSG2)
+}
+
+// UserMessage returns the sub-message of a userspace record. The fields of the
+// sub-message are also part of Data, but UserMessage keeps them apart from
+// the fields of the record that wraps it. An error is returned if the message
+// is not a userspace record or it has no msg='...' payload.
+func (m *AuditMessage) UserMessage() (*UserMessage, error) {
+	if !isUserMessageType(m.RecordType) {
+		return nil, errors.Errorf("message type is %v, not a user message", m.RecordType)
+	}
+
+	data, err := m.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, found := userMessagePayload(m.RawData[m.offset:])
+	if !found {
+		return nil, errors.New("user message has no msg payload")
+	}
+
+	raw := map[string]Field{}
+	extractKeyValuePairs(trimAuditHeader(payload), raw)
+
+	um := &UserMessage{Fields: make(map[string]string, len(raw))}
+	for k := range raw {
+		if k == "res" || k == "success" {
+			k = "result"
+		}
+		if v, found := data[k]; found {
+			um.Fields[k] = v
+		}
+	}
+
+	um.Op = um.Fields["op"]
+	if idx := strings.IndexByte(um.Op, ':'); idx != -1 {
+		um.Subsystem, um.Operation = um.Op[:idx], um.Op[idx+1:]
+	} else {
+		um.Operation = um.Op
+	}
+	if grantors := um.Fields["grantors"]; grantors != "" {
+		um.Grantors = strings.Split(grantors, ",")
+	}
+	return um, nil
+}
+
+// pamOperation adds the operation of a PAM record (e.g. op=PAM:session_open)
+// without the PAM: prefix as pam_operation.
+func pamOperation(data map[string]Field) {
+	field, found := data["op"]
+	if !found {
+		return
+	}
+	if op := field.Value(); strings.HasPrefix(op, "PAM:") {
+		data["pam_operation"] = newField(op[len("PAM:"):])
+	}
+}
+
+// userMessagePayload returns the value of the quoted msg field in body. The
+// closing quote is sometimes lost so an unterminated value runs to the end of
+// body.
+func userMessagePayload(body string) (string, bool) {
+	for i := 0; ; {
+		idx := strings.Index(body[i:], "msg=")
+		if idx == -1 {
+			return "", false
+		}
+		start := i + idx
+		i = start + len("msg=")
+		if start > 0 && body[start-1] != ' ' {
+			continue
+		}
+		if i >= len(body) || (body[i] != '\'' && body[i] != '"') {
+			continue
+		}
+
+		quote, value := body[i], body[i+1:]
+		if end := strings.IndexByte(value, quote); end != -1 {
+			value = value[:end]
+		}
+		return value, true
+	}
+}
@@ -0,0 +1,112 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserMessage(t *testing.T) {
+	msg, err := ParseLogLine(`type=USER_AUTH msg=audit(1611352420.090:1431): pid=1499 uid=0 auid=4294967295 ses=4294967295 subj=system_u:system_r:sshd_t:s0-s0:c0.c1023 msg='op=PAM:authentication grantors=pam_unix acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	um, err := msg.UserMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "PAM:authentication", um.Op)
+	assert.Equal(t, "PAM", um.Subsystem)
+	assert.Equal(t, "authentication", um.Operation)
+	assert.Equal(t, map[string]string{
+		"op":       "PAM:authentication",
+		"grantors": "pam_unix",
+		"acct":     "vagrant",
+		"exe":      "/usr/sbin/sshd",
+		"hostname": "10.0.2.2",
+		"addr":     "10.0.2.2",
+		"terminal": "ssh",
+		"result":   "success",
+	}, um.Fields)
+
+	msg, err = ParseLogLine(`type=CWD msg=audit(1611352422.102:1445): cwd="/root"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = msg.UserMessage()
+	assert.Error(t, err)
+}
+
+func TestUserMessagePAM(t *testing.T) {
+	msg, err := ParseLogLine(`type=USER_START msg=audit(1611352420.310:1436): pid=1499 uid=0 auid=1000 ses=2 subj=system_u:system_r:sshd_t:s0-s0:c0.c1023 msg='op=PAM:session_open grantors=pam_selinux,pam_loginuid,pam_keyinit,pam_limits,pam_systemd,pam_unix,pam_lastlog acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "session_open", data["pam_operation"])
+
+	um, err := msg.UserMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"pam_selinux", "pam_loginuid", "pam_keyinit", "pam_limits", "pam_systemd", "pam_unix", "pam_lastlog"}, um.Grantors)
+
+	// Failed operations have no grantors and non-PAM operations have no
+	// pam_operation.
+	msg, err = ParseLogLine(`type=USER_AUTH msg=audit(1611352420.090:1432): pid=1499 uid=0 auid=4294967295 ses=4294967295 msg='op=PAM:authentication grantors=? acct="vagrant" exe="/usr/sbin/sshd" hostname=10.0.2.2 addr=10.0.2.2 terminal=ssh res=failed'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	um, err = msg.UserMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, um.Grantors)
+
+	msg, err = ParseLogLine(`type=USER_LOGIN msg=audit(1610903553.686:584): pid=2240 uid=0 auid=1000 ses=3 msg='op=login id=1000 exe="/usr/sbin/sshd" hostname=? addr=10.0.2.2 terminal=ssh res=success'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = msg.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, data, "pam_operation")
+}
+
+func TestUserMessagePayload(t *testing.T) {
+	for body, expected := range map[string]string{
+		`pid=1 msg='op=login acct="root" res=success'`:   `op=login acct="root" res=success`,
+		`pid=1 msg='op=login acct="root" res=success`:    `op=login acct="root" res=success`,
+		`pid=1 comm="msg='x'" msg='op=login res=failed'`: `op=login res=failed`,
+	} {
+		payload, found := userMessagePayload(body)
+		assert.True(t, found, body)
+		assert.Equal(t, expected, payload, body)
+	}
+
+	_, found := userMessagePayload(`pid=1 uid=0`)
+	assert.False(t, found)
+}
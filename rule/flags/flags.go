@@ -28,15 +28,22 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/elastic/go-libaudit/v2/auparse"
 	"github.com/elastic/go-libaudit/v2/rule"
 )
 
 // Parse parses an audit rule specified using flags. It can parse delete all
-// commands (-D), file watch rules (-w), and syscall rules (-a or -A).
+// commands (-D), file watch rules (-w), and syscall rules (-a or -A). Values
+// may be quoted like in a shell (e.g. -F path="/a b").
 func Parse(args string) (rule.Rule, error) {
+	tokens, err := auparse.SplitRuleArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse the flags.
 	ruleFlagSet := newRuleFlagSet()
-	if err := ruleFlagSet.flagSet.Parse(strings.Fields(args)); err != nil {
+	if err := ruleFlagSet.flagSet.Parse(tokens); err != nil {
 		return nil, err
 	}
 	if err := ruleFlagSet.validate(); err != nil {
@@ -216,37 +223,36 @@ func (l filterList) String() string {
 
 type interFieldFilter rule.FilterSpec
 
-var comparisonRegexp = regexp.MustCompile(`(\w+)\s*(!?=)(\w+)`)
-
 func (f *interFieldFilter) Set(value string) error {
-	values := comparisonRegexp.FindStringSubmatch(value)
-	if len(values) != 4 {
+	// Comparisons use the -F grammar, limited to = and != between fields.
+	field, err := auparse.ParseRuleField(value)
+	if err != nil || (field.Op != "=" && field.Op != "!=") || !comparisonFieldRegexp.MatchString(field.Value) {
 		return fmt.Errorf("invalid comparison: '%v'", value)
 	}
 
 	f.Type = rule.InterFieldFilterType
-	f.LHS = values[1]
-	f.Comparator = values[2]
-	f.RHS = values[3]
+	f.LHS = field.Field
+	f.Comparator = field.Op
+	f.RHS = field.Value
 	return nil
 }
 
+var comparisonFieldRegexp = regexp.MustCompile(`^\w+$`)
+
 // --- valueFilterFlag ---
 
 type valueFilter rule.FilterSpec
 
-var filterRegexp = regexp.MustCompile(`(\w+)\s*(<=|>=|&=|=|!=|<|>|&)(\S+)`)
-
 func (f *valueFilter) Set(value string) error {
-	values := filterRegexp.FindStringSubmatch(value)
-	if len(values) != 4 {
+	field, err := auparse.ParseRuleField(value)
+	if err != nil {
 		return fmt.Errorf("invalid filter: '%v'", value)
 	}
 
 	f.Type = rule.ValueFilterType
-	f.LHS = values[1]
-	f.Comparator = values[2]
-	f.RHS = values[3]
+	f.LHS = field.Field
+	f.Comparator = field.Op
+	f.RHS = field.Value
 	return nil
 }
 
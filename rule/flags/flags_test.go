@@ -74,6 +74,28 @@ func TestFlagsParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			`-a always,exit -F path="/etc/my shadow" -F perm=wa`,
+			&SyscallRule{
+				Type:   AppendSyscallRuleType,
+				Action: "always",
+				List:   "exit",
+				Filters: []FilterSpec{
+					{
+						Type:       ValueFilterType,
+						LHS:        "path",
+						Comparator: "=",
+						RHS:        "/etc/my shadow",
+					},
+					{
+						Type:       ValueFilterType,
+						LHS:        "perm",
+						Comparator: "=",
+						RHS:        "wa",
+					},
+				},
+			},
+		},
 		{
 			"-D",
 			&DeleteAllRule{Type: DeleteAllRuleType},